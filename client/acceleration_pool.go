@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+// Media types of the devices of an acceleration pool.
+const (
+	AccelerationPoolMediaTypeNvdimm = "NVDIMM"
+	AccelerationPoolMediaTypeSsd    = "SSD"
+)
+
+// AccelerationPool defines an acceleration pool of a protection domain.
+type AccelerationPool struct {
+	ID                 string               `json:"id"`
+	Name               string               `json:"name"`
+	ProtectionDomainID string               `json:"protectionDomainId"`
+	MediaType          string               `json:"mediaType"`
+	Links              []*scaleiotypes.Link `json:"links"`
+}
+
+// AccelerationPoolCreateParam defines the parameters of the creation of an acceleration pool.
+type AccelerationPoolCreateParam struct {
+	Name               string `json:"name,omitempty"`
+	ProtectionDomainID string `json:"protectionDomainId"`
+	MediaType          string `json:"mediaType"`
+}
+
+// AccelerationPoolRenameParam defines the parameters of the renaming of an acceleration pool.
+type AccelerationPoolRenameParam struct {
+	NewName string `json:"newName"`
+}
+
+// AccelerationDeviceParam defines the parameters of the addition of a device to an acceleration pool.
+type AccelerationDeviceParam struct {
+	Name                     string `json:"name,omitempty"`
+	DeviceCurrentPathname    string `json:"deviceCurrentPathname"`
+	AccelerationPoolID       string `json:"accelerationPoolId"`
+	SdsID                    string `json:"sdsId"`
+	MediaType                string `json:"mediaType,omitempty"`
+	ExternalAccelerationType string `json:"externalAccelerationType,omitempty"`
+}
+
+// accelerationPoolAction returns the path of an action on an acceleration pool.
+func accelerationPoolAction(id, action string) string {
+	return fmt.Sprintf("/api/instances/AccelerationPool::%s/action/%s", id, action)
+}
+
+// CreateAccelerationPool creates an acceleration pool in a protection domain and returns its ID.
+func CreateAccelerationPool(ctx context.Context, c *goscaleio.Client, param *AccelerationPoolCreateParam) (string, error) {
+	var resp AccelerationPool
+	if err := Do(ctx, c, http.MethodPost, "/api/types/AccelerationPool/instances", param, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// GetAccelerationPool returns an acceleration pool by its ID.
+func GetAccelerationPool(ctx context.Context, c *goscaleio.Client, id string) (*AccelerationPool, error) {
+	var pool AccelerationPool
+	if err := Do(ctx, c, http.MethodGet, fmt.Sprintf("/api/instances/AccelerationPool::%s", id), nil, &pool); err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// GetAccelerationPoolDevices returns the devices of an acceleration pool.
+func GetAccelerationPoolDevices(ctx context.Context, c *goscaleio.Client, id string) ([]scaleiotypes.Device, error) {
+	var devices []scaleiotypes.Device
+	path := fmt.Sprintf("/api/instances/AccelerationPool::%s/relationships/Device", id)
+	if err := Do(ctx, c, http.MethodGet, path, nil, &devices); err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+// RenameAccelerationPool renames an acceleration pool.
+func RenameAccelerationPool(ctx context.Context, c *goscaleio.Client, id, name string) error {
+	return Do(ctx, c, http.MethodPost, accelerationPoolAction(id, "setAccelerationPoolName"), &AccelerationPoolRenameParam{NewName: name}, nil)
+}
+
+// RemoveAccelerationPool removes an acceleration pool, it must not hold any device.
+func RemoveAccelerationPool(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, accelerationPoolAction(id, "removeAccelerationPool"), &emptyParam{}, nil)
+}
+
+// AddAccelerationDevice adds a device of an SDS to an acceleration pool and returns its ID.
+func AddAccelerationDevice(ctx context.Context, c *goscaleio.Client, param *AccelerationDeviceParam) (string, error) {
+	var resp scaleiotypes.DeviceResp
+	if err := Do(ctx, c, http.MethodPost, "/api/types/Device/instances", param, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// RemoveAccelerationDevice removes a device from its acceleration pool.
+func RemoveAccelerationDevice(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, fmt.Sprintf("/api/instances/Device::%s/action/removeDevice", id), &emptyParam{}, nil)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// authPaths are the requests sent while logging in, they are never replayed.
+var authPaths = []string{"/api/login", "/api/version"}
+
+// AuthTransport replays once the requests rejected with 401, after logging in again
+// when the session token they carried has expired.
+type AuthTransport struct {
+	next http.RoundTripper
+	// login creates a new session.
+	login func() error
+	// token returns the token of the current session.
+	token func() string
+	// mu serializes the logins, so that the requests failing together only renew the session once.
+	mu sync.Mutex
+}
+
+// NewAuthTransport returns an AuthTransport renewing the session with login.
+// The transport the requests are sent through is set by the client builders.
+func NewAuthTransport(login func() error, token func() string) *AuthTransport {
+	return &AuthTransport{login: login, token: token}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(withBody(req, body))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || isAuthRequest(req) {
+		return resp, err
+	}
+
+	sentToken := tokenOf(req)
+	if sentToken == "" {
+		// the client has not logged in yet, there is no session to renew
+		return resp, nil
+	}
+	token, err := t.renew(sentToken)
+	if err != nil {
+		// keep the original answer, it explains why the request failed
+		return resp, nil
+	}
+	replay := withBody(req, body)
+	if strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		replay.Header.Set("Authorization", "Bearer "+token)
+	} else {
+		replay.SetBasicAuth("", token)
+	}
+	resp.Body.Close()
+	return t.next.RoundTrip(replay)
+}
+
+// renew logs in again unless another request already renewed the session the expired token belonged to.
+func (t *AuthTransport) renew(expiredToken string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if token := t.token(); token != expiredToken && token != "" {
+		return token, nil
+	}
+	if err := t.login(); err != nil {
+		return "", err
+	}
+	return t.token(), nil
+}
+
+// isAuthRequest reports whether the request is part of a login.
+func isAuthRequest(req *http.Request) bool {
+	for _, path := range authPaths {
+		if strings.HasSuffix(req.URL.Path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenOf returns the session token sent with the request, either as a bearer token
+// or as the password of a basic authentication with an empty user name.
+func tokenOf(req *http.Request) string {
+	auth := req.Header.Get("Authorization")
+	if strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimPrefix(auth, "Bearer ")
+	}
+	if !strings.HasPrefix(auth, "Basic ") {
+		return ""
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(auth, "Basic "))
+	if err != nil {
+		return ""
+	}
+	user, token, ok := strings.Cut(string(decoded), ":")
+	if !ok || user != "" {
+		return ""
+	}
+	return token
+}
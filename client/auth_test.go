@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dell/goscaleio"
+)
+
+// sessionServer is a fake REST API whose session token can be expired on demand.
+type sessionServer struct {
+	mu     sync.Mutex
+	token  string
+	logins int
+}
+
+func (s *sessionServer) expire() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+}
+
+func (s *sessionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.URL.Path == "/api/login" {
+		s.logins++
+		s.token = fmt.Sprintf("token-%d", s.logins)
+		io.WriteString(w, `"`+s.token+`"`)
+		return
+	}
+	if _, token, ok := r.BasicAuth(); !ok || token == "" || token != s.token {
+		// answered by the web server in front of the API, not in the JSON format of PowerFlex
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, "Unauthorized")
+		return
+	}
+	switch r.URL.Path {
+	case "/api/version":
+		io.WriteString(w, `"3.6"`)
+	case "/api/types/System/instances":
+		io.WriteString(w, `[{"id":"system-1"}]`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestAuthTransportRenewsExpiredSession(t *testing.T) {
+	fake := &sessionServer{}
+	server := httptest.NewTLSServer(fake)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, 10, true, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Authenticate(&goscaleio.ConfigConnect{Endpoint: server.URL, Username: "admin", Password: "password"}); err != nil {
+		t.Fatal(err)
+	}
+
+	fake.expire()
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			systems, err := c.GetSystems()
+			if err == nil && (len(systems) != 1 || systems[0].ID != "system-1") {
+				err = fmt.Errorf("unexpected systems %v", systems)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("request should succeed after logging in again: %s", err.Error())
+		}
+	}
+	if fake.logins != 2 {
+		t.Errorf("got %d logins, want 2", fake.logins)
+	}
+}
+
+func TestGatewayDoesNotReplayUnauthorized(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	gc, err := NewGateway(server.URL, "admin", "wrong-password", true, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// goscaleio ignores the status of the answer, the package list is empty
+	packages, _ := gc.GetPackageDetails()
+	// every attempt with wrong credentials counts towards the lockout of the account
+	if calls != 1 || len(packages) != 0 {
+		t.Errorf("got %d calls and %d packages, want 1 call and no package", calls, len(packages))
+	}
+}
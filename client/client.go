@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client builds the goscaleio clients used by the provider,
+// decorates their HTTP transport with the behavior goscaleio lacks and
+// implements the REST API operations goscaleio does not cover.
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/dell/goscaleio"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried by default.
+	DefaultMaxRetries = 3
+	// DefaultMinBackoff is the default delay before the first retry.
+	DefaultMinBackoff = 1 * time.Second
+	// DefaultMaxBackoff is the default upper bound of the delay between retries.
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// Config holds the settings of the HTTP transport shared by the PowerFlex clients.
+type Config struct {
+	// MaxRetries is the number of times a failed request is retried, zero disables retries.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry, it doubles after every attempt.
+	MinBackoff time.Duration
+	// MaxBackoff is the upper bound of the delay between retries.
+	MaxBackoff time.Duration
+	// CACertificate is the PEM content or the path of the CA bundle the server certificate is verified with,
+	// in addition to the system certificates.
+	CACertificate string
+	// ClientCertificate is the PEM content or the path of the certificate presented to the server.
+	ClientCertificate string
+	// ClientKey is the PEM content or the path of the private key of the client certificate.
+	ClientKey string
+}
+
+// DefaultConfig returns the configuration used when the provider does not override it.
+func DefaultConfig() Config {
+	return Config{
+		MaxRetries: DefaultMaxRetries,
+		MinBackoff: DefaultMinBackoff,
+		MaxBackoff: DefaultMaxBackoff,
+	}
+}
+
+// NewClient returns a goscaleio client for the REST API whose requests go through the transport described by cfg.
+func NewClient(endpoint string, timeout int64, insecure bool, cfg Config) (*goscaleio.Client, error) {
+	c, err := goscaleio.NewClientWithArgs(endpoint, "", timeout, insecure, true)
+	if err != nil {
+		return nil, err
+	}
+	apiClient, err := apiClientOf(c)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := httpClientOf(reflect.ValueOf(apiClient))
+	if err != nil {
+		return nil, err
+	}
+	// the session token expires, log in again with the credentials stored by Authenticate
+	login := func() error {
+		_, err := c.Authenticate(c.GetConfigConnect())
+		return err
+	}
+	if err := decorate(httpClient, cfg, NewAuthTransport(login, c.GetToken)); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewGateway returns a goscaleio client for the installer gateway whose requests go through the transport described by cfg.
+func NewGateway(endpoint, username, password string, insecure bool, cfg Config) (*goscaleio.GatewayClient, error) {
+	gc, err := goscaleio.NewGateway(endpoint, username, password, insecure, true)
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := httpClientOf(reflect.ValueOf(gc))
+	if err != nil {
+		return nil, err
+	}
+	// the gateway client sends its credentials with every request, so there is no session to renew:
+	// a 401 means the credentials are wrong, and sending them again only counts towards the lockout of the account
+	if err := decorate(httpClient, cfg, nil); err != nil {
+		return nil, err
+	}
+	return gc, nil
+}
+
+// decorate applies the TLS settings to the transport of the HTTP client and wraps it,
+// the auth transport, when there is a session to renew, replays the requests rejected with 401 through the retry transport.
+func decorate(httpClient *http.Client, cfg Config, auth *AuthTransport) error {
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport.(*http.Transport).Clone()
+	}
+	if err := configureTLS(next, cfg); err != nil {
+		return err
+	}
+	if auth == nil {
+		httpClient.Transport = NewRetryTransport(next, cfg)
+		return nil
+	}
+	auth.next = NewRetryTransport(next, cfg)
+	httpClient.Transport = auth
+	return nil
+}
+
+// readBody reads and closes the body of the request, so that it can be sent again.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return io.ReadAll(req.Body)
+}
+
+// withBody returns a copy of the request sending the given body.
+func withBody(req *http.Request, body []byte) *http.Request {
+	clone := req.Clone(req.Context())
+	if body != nil {
+		clone.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return clone
+}
+
+// httpClientOf returns the *http.Client held in the unexported http field of a goscaleio client,
+// goscaleio does not offer any other way to configure the transport of its clients.
+// The version of goscaleio is pinned in go.mod and TestGoscaleioFields fails when the field changes.
+func httpClientOf(v reflect.Value) (*http.Client, error) {
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unable to access the HTTP client of goscaleio")
+	}
+	field := v.FieldByName("http")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(&http.Client{}) || field.IsNil() {
+		return nil, fmt.Errorf("unable to access the HTTP client of goscaleio")
+	}
+	return (*http.Client)(field.UnsafePointer()), nil
+}
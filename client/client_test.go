@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/dell/goscaleio"
+	"github.com/dell/goscaleio/api"
+)
+
+// TestGoscaleioFields fails as soon as goscaleio renames or retypes the unexported fields
+// httpClientOf and apiClientOf read, so that an upgrade of the pinned version is caught here.
+func TestGoscaleioFields(t *testing.T) {
+	field, ok := reflect.TypeOf(goscaleio.Client{}).FieldByName("api")
+	if !ok || field.Type != reflect.TypeOf((*api.Client)(nil)).Elem() {
+		t.Fatalf("goscaleio.Client has no api field of type api.Client")
+	}
+	field, ok = reflect.TypeOf(goscaleio.GatewayClient{}).FieldByName("http")
+	if !ok || field.Type != reflect.TypeOf(&http.Client{}) {
+		t.Fatalf("goscaleio.GatewayClient has no http field of type *http.Client")
+	}
+
+	c, err := goscaleio.NewClientWithArgs("https://localhost", "", 10, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apiClient, err := apiClientOf(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := httpClientOf(reflect.ValueOf(apiClient)); err != nil {
+		t.Errorf("the api client of goscaleio has no http field of type *http.Client: %s", err.Error())
+	}
+	gc, err := goscaleio.NewGateway("https://localhost", "admin", "password", true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := httpClientOf(reflect.ValueOf(gc)); err != nil {
+		t.Error(err)
+	}
+}
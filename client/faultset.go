@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+// FaultSet defines a fault set of a protection domain.
+type FaultSet struct {
+	ID                 string               `json:"id"`
+	Name               string               `json:"name"`
+	ProtectionDomainID string               `json:"protectionDomainId"`
+	Links              []*scaleiotypes.Link `json:"links"`
+}
+
+// FaultSetCreateParam defines the parameters of the creation of a fault set.
+type FaultSetCreateParam struct {
+	Name               string `json:"name,omitempty"`
+	ProtectionDomainID string `json:"protectionDomainId"`
+}
+
+// FaultSetRenameParam defines the parameters of the renaming of a fault set.
+type FaultSetRenameParam struct {
+	NewName string `json:"newName"`
+}
+
+// faultSetAction returns the path of an action on a fault set.
+func faultSetAction(id, action string) string {
+	return fmt.Sprintf("/api/instances/FaultSet::%s/action/%s", id, action)
+}
+
+// CreateFaultSet creates a fault set in a protection domain and returns its ID.
+func CreateFaultSet(ctx context.Context, c *goscaleio.Client, param *FaultSetCreateParam) (string, error) {
+	var resp FaultSet
+	if err := Do(ctx, c, http.MethodPost, "/api/types/FaultSet/instances", param, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// GetFaultSet returns a fault set by its ID.
+func GetFaultSet(ctx context.Context, c *goscaleio.Client, id string) (*FaultSet, error) {
+	var faultSet FaultSet
+	if err := Do(ctx, c, http.MethodGet, fmt.Sprintf("/api/instances/FaultSet::%s", id), nil, &faultSet); err != nil {
+		return nil, err
+	}
+	return &faultSet, nil
+}
+
+// GetFaultSets returns all the fault sets of the system.
+func GetFaultSets(ctx context.Context, c *goscaleio.Client) ([]*FaultSet, error) {
+	var faultSets []*FaultSet
+	if err := Do(ctx, c, http.MethodGet, "/api/types/FaultSet/instances", nil, &faultSets); err != nil {
+		return nil, err
+	}
+	return faultSets, nil
+}
+
+// GetProtectionDomainFaultSets returns the fault sets of a protection domain.
+func GetProtectionDomainFaultSets(ctx context.Context, c *goscaleio.Client, pdID string) ([]*FaultSet, error) {
+	var faultSets []*FaultSet
+	path := fmt.Sprintf("/api/instances/ProtectionDomain::%s/relationships/FaultSet", pdID)
+	if err := Do(ctx, c, http.MethodGet, path, nil, &faultSets); err != nil {
+		return nil, err
+	}
+	return faultSets, nil
+}
+
+// FindProtectionDomainFaultSet returns the fault set of a protection domain with the given name.
+func FindProtectionDomainFaultSet(ctx context.Context, c *goscaleio.Client, pdID, name string) (*FaultSet, error) {
+	faultSets, err := GetProtectionDomainFaultSets(ctx, c, pdID)
+	if err != nil {
+		return nil, err
+	}
+	for _, faultSet := range faultSets {
+		if faultSet.Name == name {
+			return faultSet, nil
+		}
+	}
+	return nil, fmt.Errorf("couldn't find fault set %s in protection domain %s", name, pdID)
+}
+
+// GetFaultSetSds returns the SDSs of a fault set.
+func GetFaultSetSds(ctx context.Context, c *goscaleio.Client, id string) ([]scaleiotypes.Sds, error) {
+	var sdsList []scaleiotypes.Sds
+	path := fmt.Sprintf("/api/instances/FaultSet::%s/relationships/Sds", id)
+	if err := Do(ctx, c, http.MethodGet, path, nil, &sdsList); err != nil {
+		return nil, err
+	}
+	return sdsList, nil
+}
+
+// RenameFaultSet renames a fault set.
+func RenameFaultSet(ctx context.Context, c *goscaleio.Client, id, name string) error {
+	return Do(ctx, c, http.MethodPost, faultSetAction(id, "setFaultSetName"), &FaultSetRenameParam{NewName: name}, nil)
+}
+
+// RemoveFaultSet removes a fault set, it must not hold any SDS.
+func RemoveFaultSet(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, faultSetAction(id, "removeFaultSet"), &emptyParam{}, nil)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/goscaleio"
+)
+
+// Modes of the MDM cluster.
+const (
+	ClusterModeOneNode    = "OneNode"
+	ClusterModeThreeNodes = "ThreeNodes"
+	ClusterModeFiveNodes  = "FiveNodes"
+)
+
+// Roles of an MDM.
+const (
+	MdmRoleManager    = "Manager"
+	MdmRoleTieBreaker = "TieBreaker"
+)
+
+// MdmCluster defines the topology of the MDM cluster of the system.
+type MdmCluster struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	ClusterState    string `json:"clusterState"`
+	ClusterMode     string `json:"clusterMode"`
+	GoodNodesNum    int    `json:"goodNodesNum"`
+	GoodReplicasNum int    `json:"goodReplicasNum"`
+	PrimaryMdm      *Mdm   `json:"master"`
+	SecondaryMdms   []*Mdm `json:"slaves"`
+	TieBreakers     []*Mdm `json:"tieBreakers"`
+	StandbyMdms     []*Mdm `json:"standbyMDMs"`
+}
+
+// Mdm defines a member of the MDM cluster.
+type Mdm struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Port          int      `json:"port"`
+	IPs           []string `json:"ips"`
+	ManagementIPs []string `json:"managementIPs"`
+	Role          string   `json:"role"`
+	Status        string   `json:"status"`
+	VersionInfo   string   `json:"versionInfo"`
+}
+
+// StandbyMdmParam defines the parameters of the addition of a standby MDM.
+type StandbyMdmParam struct {
+	IPs           []string `json:"ips"`
+	Role          string   `json:"role"`
+	ManagementIPs []string `json:"managementIps,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	Port          string   `json:"port,omitempty"`
+}
+
+// MdmIDParam defines the parameters of the actions targeting a single MDM.
+type MdmIDParam struct {
+	ID string `json:"id"`
+}
+
+// MdmRenameParam defines the parameters of the renaming of an MDM.
+type MdmRenameParam struct {
+	ID      string `json:"id"`
+	NewName string `json:"newName"`
+}
+
+// SwitchClusterModeParam defines the parameters of the switch of the MDM cluster mode,
+// the standby MDMs to promote and the members to demote are given by their IDs.
+type SwitchClusterModeParam struct {
+	Mode                string   `json:"mode"`
+	AddSecondaryMdms    []string `json:"addSecondaryMdms,omitempty"`
+	RemoveSecondaryMdms []string `json:"removeSecondaryMdms,omitempty"`
+	AddTBs              []string `json:"addTBs,omitempty"`
+	RemoveTBs           []string `json:"removeTBs,omitempty"`
+}
+
+// systemAction returns the path of an action on the system.
+func systemAction(action string) string {
+	return fmt.Sprintf("/api/instances/System/action/%s", action)
+}
+
+// GetMdmCluster returns the topology of the MDM cluster.
+func GetMdmCluster(ctx context.Context, c *goscaleio.Client) (*MdmCluster, error) {
+	var cluster MdmCluster
+	if err := Do(ctx, c, http.MethodPost, systemAction("queryMdmCluster"), &emptyParam{}, &cluster); err != nil {
+		return nil, err
+	}
+	return &cluster, nil
+}
+
+// AddStandbyMdm adds a standby MDM to the cluster and returns its ID.
+func AddStandbyMdm(ctx context.Context, c *goscaleio.Client, param *StandbyMdmParam) (string, error) {
+	var resp Mdm
+	if err := Do(ctx, c, http.MethodPost, systemAction("addStandbyMdm"), param, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// RemoveStandbyMdm removes a standby MDM from the cluster.
+func RemoveStandbyMdm(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, systemAction("removeStandbyMdm"), &MdmIDParam{ID: id}, nil)
+}
+
+// SwitchClusterMode changes the mode of the MDM cluster, promoting and demoting its members.
+func SwitchClusterMode(ctx context.Context, c *goscaleio.Client, param *SwitchClusterModeParam) error {
+	return Do(ctx, c, http.MethodPost, systemAction("switchClusterMode"), param, nil)
+}
+
+// ChangeMdmOwnership makes a secondary MDM the primary MDM of the cluster.
+func ChangeMdmOwnership(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, systemAction("changeMdmOwnership"), &MdmIDParam{ID: id}, nil)
+}
+
+// RenameMdm renames a member of the MDM cluster.
+func RenameMdm(ctx context.Context, c *goscaleio.Client, id, name string) error {
+	return Do(ctx, c, http.MethodPost, systemAction("renameMdm"), &MdmRenameParam{ID: id, NewName: name}, nil)
+}
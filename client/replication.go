@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+// Pause modes of a replication consistency group.
+const (
+	PauseModeNone             = "None"
+	PauseModeStopDataTransfer = "StopDataTransfer"
+)
+
+// Failover types, freeze states and replication directions of a replication consistency group.
+const (
+	FailoverTypeNone       = "None"
+	FailoverTypeFailover   = "Failover"
+	FailoverTypeSwitchover = "Switchover"
+	FailoverStateDone      = "Done"
+	FreezeStateFrozen      = "Frozen"
+	FreezeStateUnfrozen    = "Unfrozen"
+	DirectionLocalToRemote = "LocalToRemote"
+	DirectionRemoteToLocal = "RemoteToLocal"
+)
+
+// PeerMdm defines the MDM of a remote system registered as a replication peer.
+// The IP list of goscaleio does not match the one returned by PowerFlex.
+type PeerMdm struct {
+	ID                  string               `json:"id"`
+	Name                string               `json:"name"`
+	Port                int                  `json:"port"`
+	PeerSystemID        string               `json:"peerSystemId"`
+	SystemID            string               `json:"systemId"`
+	SoftwareVersionInfo string               `json:"softwareVersionInfo"`
+	MembershipState     string               `json:"membershipState"`
+	PerfProfile         string               `json:"perfProfile"`
+	NetworkType         string               `json:"networkType"`
+	CouplingRC          string               `json:"couplingRC"`
+	IPList              []*PeerMdmIP         `json:"ipList"`
+	Links               []*scaleiotypes.Link `json:"links"`
+}
+
+// PeerMdmIP defines an IP of a peer MDM.
+type PeerMdmIP struct {
+	IP string `json:"ip"`
+}
+
+// PeerMdmCreateParam defines the parameters of the registration of a peer MDM.
+type PeerMdmCreateParam struct {
+	Name          string   `json:"name,omitempty"`
+	PeerSystemID  string   `json:"peerSystemId"`
+	PeerSystemIps []string `json:"peerSystemIps"`
+	Port          string   `json:"port,omitempty"`
+	PerfProfile   string   `json:"perfProfile,omitempty"`
+}
+
+// PeerMdmRenameParam defines the parameters of the renaming of a peer MDM.
+type PeerMdmRenameParam struct {
+	NewName string `json:"newName"`
+}
+
+// PeerMdmIPParam defines the parameters of the modification of the IPs of a peer MDM.
+type PeerMdmIPParam struct {
+	NewPeerMdmIps []string `json:"newPeerMdmIps"`
+}
+
+// PeerMdmPortParam defines the parameters of the modification of the port of a peer MDM.
+type PeerMdmPortParam struct {
+	NewPort string `json:"newPort"`
+}
+
+// PeerMdmPerfProfileParam defines the parameters of the modification of the performance profile of a peer MDM.
+type PeerMdmPerfProfileParam struct {
+	PerfProfile string `json:"perfProfile"`
+}
+
+// RcgRenameParam defines the parameters of the renaming of a replication consistency group.
+type RcgRenameParam struct {
+	NewName string `json:"newName"`
+}
+
+// RcgRpoParam defines the parameters of the modification of the RPO of a replication consistency group.
+type RcgRpoParam struct {
+	RpoInSeconds string `json:"rpoInSeconds"`
+}
+
+// RcgTargetVolumeAccessModeParam defines the parameters of the modification of the access mode
+// of the target volumes of a replication consistency group.
+type RcgTargetVolumeAccessModeParam struct {
+	TargetVolumeAccessMode string `json:"targetVolumeAccessMode"`
+}
+
+// peerMdmAction returns the path of an action on a peer MDM.
+func peerMdmAction(id, action string) string {
+	return fmt.Sprintf("/api/instances/PeerMdm::%s/action/%s", id, action)
+}
+
+// rcgAction returns the path of an action on a replication consistency group.
+func rcgAction(id, action string) string {
+	return fmt.Sprintf("/api/instances/ReplicationConsistencyGroup::%s/action/%s", id, action)
+}
+
+// CreatePeerMdm registers the MDM of a remote system as a replication peer and returns its ID.
+func CreatePeerMdm(ctx context.Context, c *goscaleio.Client, param *PeerMdmCreateParam) (string, error) {
+	var resp PeerMdm
+	if err := Do(ctx, c, http.MethodPost, "/api/types/PeerMdm/instances", param, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// GetPeerMdm returns a peer MDM by its ID.
+func GetPeerMdm(ctx context.Context, c *goscaleio.Client, id string) (*PeerMdm, error) {
+	var peerMdm PeerMdm
+	if err := Do(ctx, c, http.MethodGet, fmt.Sprintf("/api/instances/PeerMdm::%s", id), nil, &peerMdm); err != nil {
+		return nil, err
+	}
+	return &peerMdm, nil
+}
+
+// RenamePeerMdm renames a peer MDM.
+func RenamePeerMdm(ctx context.Context, c *goscaleio.Client, id, name string) error {
+	return Do(ctx, c, http.MethodPost, peerMdmAction(id, "modifyPeerMdmName"), &PeerMdmRenameParam{NewName: name}, nil)
+}
+
+// ModifyPeerMdmIPs replaces the IPs the remote MDM is reached on.
+func ModifyPeerMdmIPs(ctx context.Context, c *goscaleio.Client, id string, ips []string) error {
+	return Do(ctx, c, http.MethodPost, peerMdmAction(id, "modifyPeerMdmIp"), &PeerMdmIPParam{NewPeerMdmIps: ips}, nil)
+}
+
+// ModifyPeerMdmPort changes the port the remote MDM is reached on.
+func ModifyPeerMdmPort(ctx context.Context, c *goscaleio.Client, id string, port int) error {
+	return Do(ctx, c, http.MethodPost, peerMdmAction(id, "modifyPeerMdmPort"), &PeerMdmPortParam{NewPort: strconv.Itoa(port)}, nil)
+}
+
+// ModifyPeerMdmPerfProfile changes the performance profile of a peer MDM.
+func ModifyPeerMdmPerfProfile(ctx context.Context, c *goscaleio.Client, id, profile string) error {
+	param := &PeerMdmPerfProfileParam{PerfProfile: profile}
+	return Do(ctx, c, http.MethodPost, peerMdmAction(id, "modifyPeerMdmPerformanceParameters"), param, nil)
+}
+
+// RemovePeerMdm unregisters a peer MDM, it must not be used by any replication consistency group.
+func RemovePeerMdm(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, peerMdmAction(id, "removePeerMdm"), &emptyParam{}, nil)
+}
+
+// RenameReplicationConsistencyGroup renames a replication consistency group.
+func RenameReplicationConsistencyGroup(ctx context.Context, c *goscaleio.Client, id, name string) error {
+	return Do(ctx, c, http.MethodPost, rcgAction(id, "renameReplicationConsistencyGroup"), &RcgRenameParam{NewName: name}, nil)
+}
+
+// ModifyReplicationConsistencyGroupRpo changes the RPO of a replication consistency group.
+func ModifyReplicationConsistencyGroupRpo(ctx context.Context, c *goscaleio.Client, id string, rpoInSeconds int) error {
+	param := &RcgRpoParam{RpoInSeconds: strconv.Itoa(rpoInSeconds)}
+	return Do(ctx, c, http.MethodPost, rcgAction(id, "modifyReplicationConsistencyGroupRpo"), param, nil)
+}
+
+// ModifyReplicationConsistencyGroupTargetVolumeAccessMode changes the access mode of the target volumes
+// of a replication consistency group.
+func ModifyReplicationConsistencyGroupTargetVolumeAccessMode(ctx context.Context, c *goscaleio.Client, id, mode string) error {
+	param := &RcgTargetVolumeAccessModeParam{TargetVolumeAccessMode: mode}
+	return Do(ctx, c, http.MethodPost, rcgAction(id, "modifyReplicationConsistencyGroupTargetVolumeAccessMode"), param, nil)
+}
+
+// ActivateReplicationConsistencyGroup starts the replication of a terminated replication consistency group.
+func ActivateReplicationConsistencyGroup(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, rcgAction(id, "activateReplicationConsistencyGroup"), &emptyParam{}, nil)
+}
+
+// TerminateReplicationConsistencyGroup stops the replication of a replication consistency group.
+func TerminateReplicationConsistencyGroup(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, rcgAction(id, "terminateReplicationConsistencyGroup"), &emptyParam{}, nil)
+}
+
+// UnfreezeReplicationConsistencyGroup resumes applying the replicated data on the target volumes of a frozen replication consistency group.
+func UnfreezeReplicationConsistencyGroup(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, rcgAction(id, "unfreezeApplyReplicationConsistencyGroup"), &emptyParam{}, nil)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/dell/goscaleio"
+	"github.com/dell/goscaleio/api"
+)
+
+// Do sends a JSON request to the REST API through the session of the goscaleio client, for the
+// operations goscaleio does not implement. resp may be nil when the response has no body.
+// The errors returned by the array are *types.Error, like the ones of goscaleio.
+func Do(ctx context.Context, c *goscaleio.Client, method, path string, body, resp interface{}) error {
+	apiClient, err := apiClientOf(c)
+	if err != nil {
+		return err
+	}
+	headers := map[string]string{
+		api.HeaderKeyAccept:      api.HeaderValContentTypeJSON,
+		api.HeaderKeyContentType: api.HeaderValContentTypeJSON,
+	}
+	version := ""
+	if cfg := c.GetConfigConnect(); cfg != nil {
+		version = cfg.Version
+	}
+	return apiClient.DoWithHeaders(ctx, method, path, headers, body, resp, version)
+}
+
+// apiClientOf returns the api.Client held in the unexported api field of a goscaleio client,
+// it sends the requests with the session token of the client.
+// The version of goscaleio is pinned in go.mod and TestGoscaleioFields fails when the field changes.
+func apiClientOf(c *goscaleio.Client) (api.Client, error) {
+	field := reflect.ValueOf(c).Elem().FieldByName("api")
+	if !field.IsValid() || field.IsNil() {
+		return nil, fmt.Errorf("unable to access the API client of goscaleio")
+	}
+	apiClient, ok := reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().Interface().(api.Client)
+	if !ok {
+		return nil, fmt.Errorf("unable to access the API client of goscaleio")
+	}
+	return apiClient, nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+func TestDoSendsRequestsWithTheSession(t *testing.T) {
+	fake := &sessionServer{}
+	server := httptest.NewTLSServer(fake)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, 10, true, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.Authenticate(&goscaleio.ConfigConnect{Endpoint: server.URL, Username: "admin", Password: "password"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var systems []*scaleiotypes.System
+	if err := Do(context.Background(), c, http.MethodGet, "/api/types/System/instances", nil, &systems); err != nil {
+		t.Fatal(err)
+	}
+	if len(systems) != 1 || systems[0].ID != "system-1" {
+		t.Errorf("unexpected systems %+v", systems)
+	}
+
+	// the requests sent by Do renew the expired sessions like the ones of goscaleio
+	fake.expire()
+	if err := Do(context.Background(), c, http.MethodGet, "/api/types/System/instances", nil, &systems); err != nil {
+		t.Fatal(err)
+	}
+	if fake.logins != 2 {
+		t.Errorf("got %d logins, want 2", fake.logins)
+	}
+
+	if err := Do(context.Background(), c, http.MethodGet, "/api/types/Unknown/instances", nil, nil); err == nil {
+		t.Error("the request of an unknown path should fail")
+	}
+}
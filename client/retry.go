@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"regexp"
+	"syscall"
+	"time"
+)
+
+// busyMessage matches the messages of the errors PowerFlex returns when it rejects a request
+// because the MDM is busy, for instance during a failover or while a rebuild is running.
+var busyMessage = regexp.MustCompile(`(?i)(in progress|try again|mdm is not available|mdm is unavailable|not connected to the mdm|not the primary mdm|mdm cluster is degraded)`)
+
+// RetryTransport retries the requests failing with a transient error.
+//
+// Requests with an idempotent method are retried on any transient error. Other requests,
+// which is how PowerFlex exposes all of its actions, are only replayed when the error
+// guarantees that they were not applied: the connection could not be established, or
+// the gateway or the MDM rejected them before processing.
+type RetryTransport struct {
+	next http.RoundTripper
+	cfg  Config
+}
+
+// NewRetryTransport returns a RetryTransport sending the requests through next.
+func NewRetryTransport(next http.RoundTripper, cfg Config) *RetryTransport {
+	return &RetryTransport{next: next, cfg: cfg}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(withBody(req, body))
+		retry := false
+		if err != nil {
+			retry = IsRetryableError(req.Method, err)
+		} else if resp.StatusCode >= http.StatusBadRequest {
+			var respBody []byte
+			respBody, err = io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+			retry = IsRetryableResponse(req.Method, resp.StatusCode, respBody)
+		}
+
+		if !retry || attempt >= t.cfg.MaxRetries {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err := sleep(req.Context(), t.backoff(attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns the delay before the retry following the given attempt, an exponential
+// backoff with jitter bounded by the configured maximum.
+func (t *RetryTransport) backoff(attempt int) time.Duration {
+	delay := t.cfg.MinBackoff
+	for i := 0; i < attempt && delay < t.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > t.cfg.MaxBackoff {
+		delay = t.cfg.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	// #nosec G404 -- the jitter does not need a secure random source
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// sleep waits for the given delay unless the context is done first.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// IsRetryableError reports whether a request which failed without a response can be retried.
+func IsRetryableError(method string, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	// the connection could not be established, so the request never reached the server
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	if !isIdempotent(method) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// IsRetryableResponse reports whether a request answered with an error status can be retried.
+func IsRetryableResponse(method string, statusCode int, body []byte) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		// the gateway turned the request away
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		// the gateway may have forwarded the request to the MDM before failing
+		return isIdempotent(method)
+	}
+	var apiErr struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &apiErr) != nil {
+		return false
+	}
+	return busyMessage.MatchString(apiErr.Message)
+}
+
+// isIdempotent reports whether a request with the given method can be sent more than once safely.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
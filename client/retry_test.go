@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dell/goscaleio"
+)
+
+var testConfig = Config{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+// newFlakyServer returns a server answering the first failures requests with the given status and body.
+func newFlakyServer(failures int32, status int, body string, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(calls, 1) <= failures {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			io.WriteString(w, body)
+			return
+		}
+		reqBody, _ := io.ReadAll(r.Body)
+		w.Write(reqBody)
+	}))
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		failures  int32
+		status    int
+		body      string
+		wantCalls int32
+		wantCode  int
+	}{
+		{"get retried on 503", http.MethodGet, 1, http.StatusServiceUnavailable, ``, 2, http.StatusOK},
+		{"get retried on 504", http.MethodGet, 2, http.StatusGatewayTimeout, ``, 3, http.StatusOK},
+		{"get gives up after max retries", http.MethodGet, 5, http.StatusServiceUnavailable, ``, 3, http.StatusServiceUnavailable},
+		{"post retried on 503", http.MethodPost, 1, http.StatusServiceUnavailable, ``, 2, http.StatusOK},
+		{"post not replayed on 504", http.MethodPost, 1, http.StatusGatewayTimeout, ``, 1, http.StatusGatewayTimeout},
+		{"post retried when mdm is busy", http.MethodPost, 1, http.StatusInternalServerError,
+			`{"message":"Another operation is in progress","httpStatusCode":500,"errorCode":0}`, 2, http.StatusOK},
+		{"post not retried on other errors", http.MethodPost, 1, http.StatusInternalServerError,
+			`{"message":"Volume name already in use","httpStatusCode":500,"errorCode":0}`, 1, http.StatusInternalServerError},
+		{"get not retried on not found", http.MethodGet, 1, http.StatusNotFound, ``, 1, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			server := newFlakyServer(tt.failures, tt.status, tt.body, &calls)
+			defer server.Close()
+
+			httpClient := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, testConfig)}
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader(`{"name":"vol"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := httpClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+
+			if calls != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", calls, tt.wantCalls)
+			}
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.wantCode)
+			}
+			if resp.StatusCode == http.StatusOK && string(body) != `{"name":"vol"}` {
+				t.Errorf("request body was not replayed, server got %q", body)
+			}
+		})
+	}
+}
+
+func TestRetryTransportConnectionRefused(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	httpClient := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, testConfig)}
+	_, err := httpClient.Post(url, "application/json", strings.NewReader(`{}`))
+	if err == nil {
+		t.Fatal("expected an error for a closed server")
+	}
+	if !IsRetryableError(http.MethodPost, err) {
+		t.Errorf("a refused connection should be retryable, got %s", err.Error())
+	}
+}
+
+func TestNewClientUsesRetryTransport(t *testing.T) {
+	var calls int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/login":
+			io.WriteString(w, `"token"`)
+		case "/api/version":
+			io.WriteString(w, `"3.6"`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient(server.URL, 10, true, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.Authenticate(&goscaleio.ConfigConnect{Endpoint: server.URL, Username: "admin", Password: "password"})
+	if err != nil {
+		t.Fatalf("authentication should succeed after a retry: %s", err.Error())
+	}
+
+	gc, err := NewGateway(server.URL, "admin", "password", true, testConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gc == nil {
+		t.Fatal("expected a gateway client")
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+// ApproveSdcParam defines the parameters of the approval of an SDC in a restricted SDC mode,
+// either by its GUID or by its IP.
+type ApproveSdcParam struct {
+	SdcGUID string `json:"sdcGuid,omitempty"`
+	SdcIP   string `json:"sdcIp,omitempty"`
+	Name    string `json:"name,omitempty"`
+}
+
+// ApproveSdc approves an SDC, which does not need to be connected yet, and returns its ID.
+// goscaleio only approves the SDCs by GUID and cannot name them.
+func ApproveSdc(ctx context.Context, c *goscaleio.Client, systemID string, param *ApproveSdcParam) (string, error) {
+	var resp scaleiotypes.ApproveSdcByGUIDResponse
+	if err := Do(ctx, c, http.MethodPost, systemInstanceAction(systemID, "approveSdc"), param, &resp); err != nil {
+		return "", err
+	}
+	return resp.SdcID, nil
+}
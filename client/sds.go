@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+// CreateSdsInFaultSet creates an SDS in a fault set of the protection domain and returns its ID.
+// It takes the same parameters as ProtectionDomain.CreateSdsWithParams of goscaleio, which ignores the fault set.
+func CreateSdsInFaultSet(ctx context.Context, c *goscaleio.Client, pdID, faultSetID string, sds *scaleiotypes.Sds) (string, error) {
+	param := &scaleiotypes.SdsParam{
+		Name:               sds.Name,
+		ProtectionDomainID: pdID,
+		FaultSetID:         faultSetID,
+		DrlMode:            sds.DrlMode,
+		RmcacheEnabled:     scaleiotypes.GetBoolType(sds.RmcacheEnabled),
+		IPList:             make([]*scaleiotypes.SdsIPList, 0, len(sds.IPList)),
+	}
+	if sds.Port != 0 {
+		param.Port = strconv.Itoa(sds.Port)
+	}
+	if sds.RmcacheSizeInKb != 0 {
+		param.RmcacheSizeInKb = strconv.Itoa(sds.RmcacheSizeInKb)
+	}
+	for _, ip := range sds.IPList {
+		param.IPList = append(param.IPList, &scaleiotypes.SdsIPList{SdsIP: *ip})
+	}
+	var resp scaleiotypes.SdsResp
+	if err := Do(ctx, c, http.MethodPost, "/api/types/Sds/instances", param, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+// SnapshotPolicyCreateParam defines the parameters of the creation of a snapshot policy.
+type SnapshotPolicyCreateParam struct {
+	Name                             string   `json:"name"`
+	AutoSnapshotCreationCadenceInMin string   `json:"autoSnapshotCreationCadenceInMin"`
+	NumOfRetainedSnapshotsPerLevel   []string `json:"numOfRetainedSnapshotsPerLevel"`
+	SnapshotAccessMode               string   `json:"snapshotAccessMode,omitempty"`
+	SecureSnapshots                  string   `json:"secureSnapshots,omitempty"`
+	Paused                           string   `json:"paused,omitempty"`
+}
+
+// SnapshotPolicyModifyParam defines the parameters of the modification of the schedule of a snapshot policy.
+type SnapshotPolicyModifyParam struct {
+	AutoSnapshotCreationCadenceInMin string   `json:"autoSnapshotCreationCadenceInMin"`
+	NumOfRetainedSnapshotsPerLevel   []string `json:"numOfRetainedSnapshotsPerLevel"`
+}
+
+// SnapshotPolicyRenameParam defines the parameters of the renaming of a snapshot policy.
+type SnapshotPolicyRenameParam struct {
+	NewName string `json:"newName"`
+}
+
+// SnapshotPolicySourceVolumeParam defines the parameters of the attachment and the detachment of a source volume.
+type SnapshotPolicySourceVolumeParam struct {
+	SourceVolumeID string `json:"sourceVolumeId"`
+	// AutoSnapshotRemovalAction is Remove or Detach, it only applies to the detachment.
+	AutoSnapshotRemovalAction string `json:"autoSnapshotRemovalAction,omitempty"`
+	DetachLockedAutoSnapshots string `json:"detachLockedAutoSnapshots,omitempty"`
+}
+
+// emptyParam is the body of the actions without parameters.
+type emptyParam struct{}
+
+// snapshotPolicyAction returns the path of an action on a snapshot policy.
+func snapshotPolicyAction(id, action string) string {
+	return fmt.Sprintf("/api/instances/SnapshotPolicy::%s/action/%s", id, action)
+}
+
+// CreateSnapshotPolicy creates a snapshot policy and returns its ID.
+func CreateSnapshotPolicy(ctx context.Context, c *goscaleio.Client, param *SnapshotPolicyCreateParam) (string, error) {
+	var resp scaleiotypes.SnapshotPolicy
+	if err := Do(ctx, c, http.MethodPost, "/api/types/SnapshotPolicy/instances", param, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// ModifySnapshotPolicy changes the schedule of a snapshot policy.
+func ModifySnapshotPolicy(ctx context.Context, c *goscaleio.Client, id string, param *SnapshotPolicyModifyParam) error {
+	return Do(ctx, c, http.MethodPost, snapshotPolicyAction(id, "modifySnapshotPolicy"), param, nil)
+}
+
+// RenameSnapshotPolicy renames a snapshot policy.
+func RenameSnapshotPolicy(ctx context.Context, c *goscaleio.Client, id, name string) error {
+	return Do(ctx, c, http.MethodPost, snapshotPolicyAction(id, "renameSnapshotPolicy"), &SnapshotPolicyRenameParam{NewName: name}, nil)
+}
+
+// PauseSnapshotPolicy stops the creation of the snapshots of a snapshot policy.
+func PauseSnapshotPolicy(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, snapshotPolicyAction(id, "pauseSnapshotPolicy"), &emptyParam{}, nil)
+}
+
+// ResumeSnapshotPolicy resumes the creation of the snapshots of a paused snapshot policy.
+func ResumeSnapshotPolicy(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, snapshotPolicyAction(id, "resumeSnapshotPolicy"), &emptyParam{}, nil)
+}
+
+// AddSourceVolumeToSnapshotPolicy attaches a source volume to a snapshot policy.
+func AddSourceVolumeToSnapshotPolicy(ctx context.Context, c *goscaleio.Client, id, volumeID string) error {
+	param := &SnapshotPolicySourceVolumeParam{SourceVolumeID: volumeID}
+	return Do(ctx, c, http.MethodPost, snapshotPolicyAction(id, "addSourceVolumeToSnapshotPolicy"), param, nil)
+}
+
+// RemoveSourceVolumeFromSnapshotPolicy detaches a source volume from a snapshot policy,
+// its auto snapshots are removed or detached according to removalAction.
+func RemoveSourceVolumeFromSnapshotPolicy(ctx context.Context, c *goscaleio.Client, id, volumeID, removalAction string) error {
+	param := &SnapshotPolicySourceVolumeParam{
+		SourceVolumeID:            volumeID,
+		AutoSnapshotRemovalAction: removalAction,
+		DetachLockedAutoSnapshots: "true",
+	}
+	return Do(ctx, c, http.MethodPost, snapshotPolicyAction(id, "removeSourceVolumeFromSnapshotPolicy"), param, nil)
+}
+
+// RemoveSnapshotPolicy removes a snapshot policy.
+func RemoveSnapshotPolicy(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, snapshotPolicyAction(id, "removeSnapshotPolicy"), &emptyParam{}, nil)
+}
+
+// GetSnapshotPolicySourceVolumes returns the source volumes attached to a snapshot policy.
+func GetSnapshotPolicySourceVolumes(ctx context.Context, c *goscaleio.Client, id string) ([]*scaleiotypes.Volume, error) {
+	var volumes []*scaleiotypes.Volume
+	path := fmt.Sprintf("/api/instances/SnapshotPolicy::%s/relationships/SourceVolume", id)
+	if err := Do(ctx, c, http.MethodGet, path, nil, &volumes); err != nil {
+		return nil, err
+	}
+	return volumes, nil
+}
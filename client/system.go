@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dell/goscaleio"
+)
+
+// The restricted SDC modes of a system.
+const (
+	RestrictedSdcModeNone       = "None"
+	RestrictedSdcModeGUID       = "Guid"
+	RestrictedSdcModeApprovedIP = "ApprovedIp"
+)
+
+// SystemSettings defines the settings of a system, which goscaleio does not all expose.
+type SystemSettings struct {
+	ID                                    string `json:"id"`
+	Name                                  string `json:"name"`
+	RestrictedSdcModeEnabled              bool   `json:"restrictedSdcModeEnabled"`
+	RestrictedSdcMode                     string `json:"restrictedSdcMode"`
+	CapacityAlertHighThresholdPercent     int    `json:"capacityAlertHighThresholdPercent"`
+	CapacityAlertCriticalThresholdPercent int    `json:"capacityAlertCriticalThresholdPercent"`
+	CliPasswordAllowed                    bool   `json:"cliPasswordAllowed"`
+}
+
+// SdcApprovedIps defines the IPs an SDC is approved to connect from in the ApprovedIp restricted SDC mode.
+type SdcApprovedIps struct {
+	ID             string   `json:"id"`
+	SdcApprovedIps []string `json:"sdcApprovedIps"`
+}
+
+// RestrictedSdcModeParam defines the parameters of the change of the restricted SDC mode.
+type RestrictedSdcModeParam struct {
+	RestrictedSdcMode string `json:"restrictedSdcMode"`
+}
+
+// SdcApprovedIpsParam defines the parameters of the change of the approved IPs of an SDC.
+type SdcApprovedIpsParam struct {
+	SdcID  string   `json:"sdcId"`
+	SdcIps []string `json:"sdcIps"`
+}
+
+// SystemCapacityAlertThresholdsParam defines the parameters of the change of the capacity alert thresholds of a system.
+type SystemCapacityAlertThresholdsParam struct {
+	CapacityAlertHighThresholdPercent     string `json:"capacityAlertHighThresholdPercent"`
+	CapacityAlertCriticalThresholdPercent string `json:"capacityAlertCriticalThresholdPercent"`
+}
+
+// CliPasswordAllowedParam defines the parameters of the change of the CLI password rule.
+type CliPasswordAllowedParam struct {
+	CliPasswordAllowed string `json:"cliPasswordAllowed"`
+}
+
+// systemInstanceAction returns the path of an action on the system with the given ID.
+func systemInstanceAction(id, action string) string {
+	return fmt.Sprintf("/api/instances/System::%s/action/%s", id, action)
+}
+
+// GetSystemSettings returns the settings of a system.
+func GetSystemSettings(ctx context.Context, c *goscaleio.Client, id string) (*SystemSettings, error) {
+	var settings SystemSettings
+	if err := Do(ctx, c, http.MethodGet, fmt.Sprintf("/api/instances/System::%s", id), nil, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// GetSdcApprovedIps returns the approved IPs of the SDCs of a system.
+func GetSdcApprovedIps(ctx context.Context, c *goscaleio.Client, id string) ([]*SdcApprovedIps, error) {
+	var sdcs []*SdcApprovedIps
+	if err := Do(ctx, c, http.MethodGet, fmt.Sprintf("/api/instances/System::%s/relationships/Sdc", id), nil, &sdcs); err != nil {
+		return nil, err
+	}
+	return sdcs, nil
+}
+
+// SetRestrictedSdcMode changes the restricted SDC mode of a system.
+func SetRestrictedSdcMode(ctx context.Context, c *goscaleio.Client, id, mode string) error {
+	return Do(ctx, c, http.MethodPost, systemInstanceAction(id, "setRestrictedSdcMode"), &RestrictedSdcModeParam{RestrictedSdcMode: mode}, nil)
+}
+
+// SetSdcApprovedIps replaces the approved IPs of an SDC, an empty list removes them.
+func SetSdcApprovedIps(ctx context.Context, c *goscaleio.Client, id, sdcID string, ips []string) error {
+	return Do(ctx, c, http.MethodPost, systemInstanceAction(id, "setApprovedSdcIps"), &SdcApprovedIpsParam{SdcID: sdcID, SdcIps: ips}, nil)
+}
+
+// SetSystemCapacityAlertThresholds changes the capacity alert thresholds of a system, in percent of its capacity.
+func SetSystemCapacityAlertThresholds(ctx context.Context, c *goscaleio.Client, id string, high, critical int) error {
+	param := &SystemCapacityAlertThresholdsParam{
+		CapacityAlertHighThresholdPercent:     strconv.Itoa(high),
+		CapacityAlertCriticalThresholdPercent: strconv.Itoa(critical),
+	}
+	return Do(ctx, c, http.MethodPost, systemInstanceAction(id, "setCapacityAlertThresholds"), param, nil)
+}
+
+// SetCliPasswordAllowed changes whether passwords can be given on the command line of the CLI.
+func SetCliPasswordAllowed(ctx context.Context, c *goscaleio.Client, id string, allowed bool) error {
+	param := &CliPasswordAllowedParam{CliPasswordAllowed: strconv.FormatBool(allowed)}
+	return Do(ctx, c, http.MethodPost, systemInstanceAction(id, "setCliPasswordAllowed"), param, nil)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// configureTLS adds the CA bundle and the client certificate of the configuration to the transport.
+func configureTLS(rt http.RoundTripper, cfg Config) error {
+	if cfg.CACertificate == "" && cfg.ClientCertificate == "" && cfg.ClientKey == "" {
+		return nil
+	}
+	transport, ok := rt.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("unable to configure TLS on a transport of type %T", rt)
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	tlsConfig := transport.TLSClientConfig
+
+	if cfg.CACertificate != "" {
+		caPEM, err := readPEM(cfg.CACertificate)
+		if err != nil {
+			return fmt.Errorf("unable to read the CA certificate: %s", err.Error())
+		}
+		pool := tlsConfig.RootCAs
+		if pool == nil {
+			if pool, err = x509.SystemCertPool(); err != nil {
+				pool = x509.NewCertPool()
+			}
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return fmt.Errorf("unable to read the CA certificate: no certificate found in the PEM content")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertificate != "" || cfg.ClientKey != "" {
+		if cfg.ClientCertificate == "" || cfg.ClientKey == "" {
+			return fmt.Errorf("both the client certificate and the client key are required")
+		}
+		certPEM, err := readPEM(cfg.ClientCertificate)
+		if err != nil {
+			return fmt.Errorf("unable to read the client certificate: %s", err.Error())
+		}
+		keyPEM, err := readPEM(cfg.ClientKey)
+		if err != nil {
+			return fmt.Errorf("unable to read the client key: %s", err.Error())
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("unable to load the client certificate: %s", err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return nil
+}
+
+// readPEM returns the value when it holds PEM content, otherwise the content of the file it points to.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN ") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(filepath.Clean(value))
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+// The roles of the users of a PowerFlex system.
+const (
+	UserRoleMonitor        = "Monitor"
+	UserRoleConfigure      = "Configure"
+	UserRoleAdministrator  = "Administrator"
+	UserRoleSecurity       = "Security"
+	UserRoleFrontendConfig = "FrontendConfig"
+	UserRoleBackendConfig  = "BackendConfig"
+	UserRoleSuperUser      = "SuperUser"
+)
+
+// UserRoles lists the roles a user can be given.
+var UserRoles = []string{
+	UserRoleMonitor,
+	UserRoleConfigure,
+	UserRoleAdministrator,
+	UserRoleSecurity,
+	UserRoleFrontendConfig,
+	UserRoleBackendConfig,
+	UserRoleSuperUser,
+}
+
+// UserCreateParam defines the parameters of the creation of a user.
+type UserCreateParam struct {
+	Name     string `json:"name"`
+	UserRole string `json:"userRole"`
+	Password string `json:"password"`
+}
+
+// UserRoleParam defines the parameters of the change of the role of a user.
+type UserRoleParam struct {
+	UserRole string `json:"userRole"`
+}
+
+// UserPasswordParam defines the parameters of the reset of the password of a user.
+type UserPasswordParam struct {
+	Password string `json:"password"`
+}
+
+// userAction returns the path of an action on a user.
+func userAction(id, action string) string {
+	return fmt.Sprintf("/api/instances/User::%s/action/%s", id, action)
+}
+
+// CreateUser creates a user and returns its ID.
+// PowerFlex requires new users to change their password at their first login.
+func CreateUser(ctx context.Context, c *goscaleio.Client, param *UserCreateParam) (string, error) {
+	var resp scaleiotypes.User
+	if err := Do(ctx, c, http.MethodPost, "/api/types/User/instances", param, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// GetUser returns a user by its ID.
+func GetUser(ctx context.Context, c *goscaleio.Client, id string) (*scaleiotypes.User, error) {
+	var user scaleiotypes.User
+	if err := Do(ctx, c, http.MethodGet, fmt.Sprintf("/api/instances/User::%s", id), nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// SetUserRole changes the role of a user.
+func SetUserRole(ctx context.Context, c *goscaleio.Client, id, role string) error {
+	return Do(ctx, c, http.MethodPost, userAction(id, "setUserRole"), &UserRoleParam{UserRole: role}, nil)
+}
+
+// ResetUserPassword resets the password of a user, who has to change it at the next login.
+func ResetUserPassword(ctx context.Context, c *goscaleio.Client, id, password string) error {
+	return Do(ctx, c, http.MethodPost, userAction(id, "resetPassword"), &UserPasswordParam{Password: password}, nil)
+}
+
+// RemoveUser removes a user.
+func RemoveUser(ctx context.Context, c *goscaleio.Client, id string) error {
+	return Do(ctx, c, http.MethodPost, userAction(id, "removeUser"), &emptyParam{}, nil)
+}
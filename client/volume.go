@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/goscaleio"
+)
+
+// OverwriteVolumeContentParam defines the parameters of the overwrite of the content of a volume.
+type OverwriteVolumeContentParam struct {
+	SrcVolumeID string `json:"srcVolumeId"`
+}
+
+// OverwriteVolumeContent overwrites the content of a volume with the content of another volume of its VTree,
+// usually a snapshot. The volume keeps its ID, its name and its SDC mappings.
+func OverwriteVolumeContent(ctx context.Context, c *goscaleio.Client, volumeID string, param *OverwriteVolumeContentParam) error {
+	return Do(ctx, c, http.MethodPost, fmt.Sprintf("/api/instances/Volume::%s/action/overwriteVolumeContent", volumeID), param, nil)
+}
+
+// RemoveConsistencyGroupSnapshotsParam defines the parameters of the removal of the snapshots of a snapshot group.
+type RemoveConsistencyGroupSnapshotsParam struct {
+	SnapGroupID string `json:"snapGroupId"`
+}
+
+// RemoveConsistencyGroupSnapshots removes all the snapshots of a snapshot group as one unit.
+// goscaleio only removes the snapshots one by one.
+func RemoveConsistencyGroupSnapshots(ctx context.Context, c *goscaleio.Client, systemID, groupID string) error {
+	param := &RemoveConsistencyGroupSnapshotsParam{SnapGroupID: groupID}
+	return Do(ctx, c, http.MethodPost, systemInstanceAction(systemID, "removeConsistencyGroupSnapshots"), param, nil)
+}
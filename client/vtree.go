@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/goscaleio"
+)
+
+// Conversions of the provisioning type of the volumes of a VTree during its migration.
+const (
+	VTreeConversionThickToThin = "ThickToThin"
+	VTreeConversionThinToThick = "ThinToThick"
+)
+
+// Migration statuses of a VTree.
+const (
+	VTreeNotInMigration  = "NotInMigration"
+	VTreeMigrationPaused = "Paused"
+)
+
+// VTree defines the tree of a volume and of its snapshots.
+type VTree struct {
+	ID            string             `json:"id"`
+	Name          string             `json:"name"`
+	BaseVolumeID  string             `json:"baseVolumeId"`
+	StoragePoolID string             `json:"storagePoolId"`
+	MigrationInfo VTreeMigrationInfo `json:"vtreeMigrationInfo"`
+}
+
+// VTreeMigrationInfo defines the progress of the migration of a VTree.
+type VTreeMigrationInfo struct {
+	SourceStoragePoolID      string `json:"sourceStoragePoolId"`
+	DestinationStoragePoolID string `json:"destinationStoragePoolId"`
+	MigrationStatus          string `json:"migrationStatus"`
+	MigrationPauseReason     string `json:"migrationPauseReason"`
+	ThicknessConversionType  string `json:"thicknessConversionType"`
+}
+
+// VTreeMigrationParam defines the parameters of the migration of a VTree to a storage pool.
+type VTreeMigrationParam struct {
+	DestSPID          string `json:"destSPId"`
+	VolTypeConversion string `json:"volTypeConversion,omitempty"`
+	CompressionMethod string `json:"compressionMethod,omitempty"`
+}
+
+// GetVTree returns a VTree by its ID.
+func GetVTree(ctx context.Context, c *goscaleio.Client, id string) (*VTree, error) {
+	var vtree VTree
+	if err := Do(ctx, c, http.MethodGet, fmt.Sprintf("/api/instances/VTree::%s", id), nil, &vtree); err != nil {
+		return nil, err
+	}
+	return &vtree, nil
+}
+
+// MigrateVTree starts the migration of the VTree of a volume, with all its snapshots, to a storage pool.
+// The migration runs in the background, with the VTree migration I/O priority policy of the storage pools.
+func MigrateVTree(ctx context.Context, c *goscaleio.Client, volumeID string, param *VTreeMigrationParam) error {
+	return Do(ctx, c, http.MethodPost, fmt.Sprintf("/api/instances/Volume::%s/action/migrateVTree", volumeID), param, nil)
+}
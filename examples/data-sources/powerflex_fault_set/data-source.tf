@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+# Reads fault set either by name or by id , if provided
+# If both name and id is not provided , then it reads all the fault sets
+# id and name can't be given together to fetch the fault set .
+# protection_domain_id restricts the fault sets read to the ones of the protection domain
+
+data "powerflex_fault_set" "fs" {
+  name = "rack1"
+  # id = "b5ae0bc200000000"
+  # protection_domain_id = "202a046600000000"
+}
+
+output "faultSetResult" {
+  value = data.powerflex_fault_set.fs.fault_sets
+}
@@ -0,0 +1,31 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+# Reads the topology of the MDM cluster of the PowerFlex array
+
+data "powerflex_mdm_cluster" "cluster" {
+}
+
+output "mdmClusterResult" {
+  value = data.powerflex_mdm_cluster.cluster
+}
+
+# IPs of the primary MDM, for example to feed them to other modules
+output "primaryMdmIPs" {
+  value = data.powerflex_mdm_cluster.cluster.primary_mdm.ips
+}
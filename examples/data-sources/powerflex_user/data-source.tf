@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+# Reads user either by name or by id , if provided
+# If both name and id is not provided , then it reads all the users of the system
+# id and name can't be given together to fetch the user .
+
+data "powerflex_user" "user" {
+  name = "monitor1"
+  # id = "e6a7458600000002"
+}
+
+output "userResult" {
+  value = data.powerflex_user.user.users
+}
@@ -29,4 +29,24 @@ provider "powerflex" {
   endpoint = var.endpoint
   insecure = true
   timeout  = 120
+  # verify the gateway certificate with an internal CA instead of setting insecure = true
+  # ca_certificate = "/path/to/ca.pem"
+  # client certificate presented to the gateway, when it requires one
+  # client_certificate = "/path/to/client.pem"
+  # client_key         = "/path/to/client.key"
+  # required only when the gateway manages more than one PowerFlex system
+  # system_id = var.system_id
+  # retries of requests failing with transient errors, like an MDM failover
+  # max_retries       = 3
+  # retry_min_backoff = "1s"
+  # retry_max_backoff = "30s"
+
+  # required only when the installer gateway used by powerflex_package and powerflex_sdc
+  # runs on another host or with other credentials than the REST API
+  # gateway {
+  #   endpoint = var.gateway_endpoint
+  #   username = var.gateway_username
+  #   password = var.gateway_password
+  #   insecure = true
+  # }
 }
\ No newline at end of file
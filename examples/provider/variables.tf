@@ -29,3 +29,28 @@ variable "endpoint" {
   type        = string
   description = "Stores the endpoint of PowerFlex host. eg: https://10.1.1.1:443, here 443 is port where API requests are getting accepted"
 }
+
+variable "system_id" {
+  type        = string
+  description = "Stores the ID of the PowerFlex system to manage, when the gateway manages more than one system."
+  default     = null
+}
+
+variable "gateway_endpoint" {
+  type        = string
+  description = "Stores the endpoint of the installer gateway, when it differs from the endpoint of PowerFlex host. eg: https://10.1.1.2:443"
+  default     = null
+}
+
+variable "gateway_username" {
+  type        = string
+  description = "Stores the username of the installer gateway, when it differs from the username of PowerFlex host."
+  default     = null
+}
+
+variable "gateway_password" {
+  type        = string
+  description = "Stores the password of the installer gateway, when it differs from the password of PowerFlex host."
+  default     = null
+  sensitive   = true
+}
@@ -0,0 +1,8 @@
+# Below are the steps to import acceleration pool :
+# Step 1 - To import an acceleration pool , we need the id of that acceleration pool
+# Step 2 - create a tf file with empty resource block . Refer the example below.
+# Example :
+# resource "powerflex_acceleration_pool" "resource_block_name" {
+# }
+# Step 3 - execute the command: terraform import "powerflex_acceleration_pool.resource_block_name" "id_of_the_acceleration_pool" (resource_block_name must be taken from step 2)
+# Step 4 - After successful execution of the command , check the state file
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import , check import.sh for more info
+# name and media_type are the required parameters to create
+# To create, either protection_domain_name or protection_domain_id must be provided
+# media_type must be either NVDIMM or SSD
+# only the name of the acceleration pool can be updated
+
+resource "powerflex_acceleration_pool" "nvdimm" {
+  name                   = "nvdimm_pool"
+  protection_domain_name = "domain1"
+  media_type             = "NVDIMM"
+}
+
+# acceleration devices are added to the acceleration pool with the device resource
+resource "powerflex_device" "nvdimm_device" {
+  device_path          = "/dev/dax0.0"
+  acceleration_pool_id = powerflex_acceleration_pool.nvdimm.id
+  sds_name             = "SDS_2"
+  media_type           = "NVDIMM"
+}
+
+output "acceleration_pool_nvdimm" {
+  value = powerflex_acceleration_pool.nvdimm
+}
@@ -19,6 +19,8 @@ limitations under the License.
 # Create, Update, Read, Delete and Import operations are supported for this resource.
 # To add device, device_path is mandatory along with storage_pool_name/storage_pool_id and sds_name/sds_id.
 # Along with storage_pool_name, we have to specify protection_domain_id or protection_domain_name.
+# To add an acceleration device, use acceleration_pool_id instead of storage_pool_name/storage_pool_id.
+# The optional timeouts block bounds how long create, update and delete may take, the default is 20m for each.
 # To check which attributes of the device resource can be updated, please refer Product Guide in the documentation
 
 resource "powerflex_device" "test-device" {
@@ -27,4 +29,9 @@ resource "powerflex_device" "test-device" {
   protection_domain_name = "domain1"
   sds_name               = "SDS_2"
   media_type             = "HDD"
+
+  timeouts {
+    create = "30m"
+    delete = "30m"
+  }
 }
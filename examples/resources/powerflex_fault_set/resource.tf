@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import , check import.sh for more info
+# name is the required parameter to create or update
+# To create, either protection_domain_name or protection_domain_id must be provided
+# only the name of the fault set can be updated
+
+resource "powerflex_fault_set" "rack1" {
+  name                   = "rack1"
+  protection_domain_name = "domain1"
+}
+
+resource "powerflex_fault_set" "rack2" {
+  name                 = "rack2"
+  protection_domain_id = "202a046600000000"
+}
+
+output "fault_set_rack1" {
+  value = powerflex_fault_set.rack1
+}
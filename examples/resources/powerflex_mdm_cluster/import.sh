@@ -0,0 +1,8 @@
+# Below are the steps to import the MDM cluster :
+# Step 1 - To import the MDM cluster , we need its id , a system has a single MDM cluster so any id is accepted
+# Step 2 - create a tf file with empty resource block . Refer the example below.
+# Example :
+# resource "powerflex_mdm_cluster" "resource_block_name" {
+# }
+# Step 3 - execute the command: terraform import "powerflex_mdm_cluster.resource_block_name" "id_of_the_mdm_cluster" (resource_block_name must be taken from step 2)
+# Step 4 - After successful execution of the command , check the state file
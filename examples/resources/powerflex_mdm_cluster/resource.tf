@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource, Create reads the existing cluster and Delete only removes it from the state
+# To import , check import.sh for more info
+# cluster_mode and primary_mdm are the required parameters
+# The MDMs are identified by their id or by their ips, the ips are required to add an MDM to the cluster
+# secondary_mdm and tiebreaker_mdm are required to switch the cluster mode, the new members are added as standby MDMs first
+# When standby_mdm is set, the standby MDMs which are not listed are removed
+# Setting a secondary MDM as primary_mdm switches the primary and secondary roles
+
+# Expand a three nodes cluster to five nodes
+resource "powerflex_mdm_cluster" "cluster" {
+  cluster_mode = "FiveNodes"
+  primary_mdm = {
+    ips = ["10.10.10.1"]
+  }
+  secondary_mdm = [
+    {
+      ips  = ["10.10.10.2"]
+      name = "mdm2"
+    },
+    {
+      ips            = ["10.10.10.4"]
+      management_ips = ["10.10.20.4"]
+      name           = "mdm4"
+    },
+  ]
+  tiebreaker_mdm = [
+    {
+      ips = ["10.10.10.3"]
+    },
+    {
+      ips = ["10.10.10.5"]
+    },
+  ]
+  standby_mdm = []
+}
+
+output "mdm_cluster" {
+  value = powerflex_mdm_cluster.cluster
+}
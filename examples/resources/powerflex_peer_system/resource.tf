@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import , check import.sh for more info
+# name, remote_system_id and ip_list are the required parameters to create
+# remote_system_id cannot be updated
+# The local system must also be registered as a peer system on the remote system
+
+resource "powerflex_peer_system" "dr_site" {
+  name                = "dr_site"
+  remote_system_id    = "4a54a8ba6df0690f"
+  ip_list             = ["10.10.10.1", "10.10.10.2"]
+  port                = 7611
+  performance_profile = "HighPerformance"
+}
+
+output "peer_system_dr_site" {
+  value = powerflex_peer_system.dr_site
+}
@@ -0,0 +1,8 @@
+# Below are the steps to import replication consistency group :
+# Step 1 - To import a replication consistency group , we need the id of that replication consistency group
+# Step 2 - create a tf file with empty resource block . Refer the example below.
+# Example :
+# resource "powerflex_replication_consistency_group" "resource_block_name" {
+# }
+# Step 3 - execute the command: terraform import "powerflex_replication_consistency_group.resource_block_name" "id_of_the_replication_consistency_group" (resource_block_name must be taken from step 2)
+# Step 4 - After successful execution of the command , check the state file
@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import , check import.sh for more info
+# name, peer_system_id, protection_domain_id, remote_protection_domain_id and rpo_in_seconds are the required parameters to create
+# peer_system_id, protection_domain_id and remote_protection_domain_id cannot be updated
+# The replication is terminated when active is false, and the data transfer is paused when paused is true
+
+resource "powerflex_peer_system" "dr_site" {
+  name             = "dr_site"
+  remote_system_id = "4a54a8ba6df0690f"
+  ip_list          = ["10.10.10.1", "10.10.10.2"]
+}
+
+resource "powerflex_replication_consistency_group" "app" {
+  name                        = "app"
+  peer_system_id              = powerflex_peer_system.dr_site.id
+  protection_domain_id        = "202a046600000000"
+  remote_protection_domain_id = "a6d2a8c700000000"
+  rpo_in_seconds              = 60
+  target_volume_access_mode   = "NoAccess"
+  active                      = true
+  paused                      = false
+}
+
+output "replication_consistency_group_app" {
+  value = powerflex_replication_consistency_group.app
+}
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create and Update run the action on the replication consistency group, import is not supported
+# replication_consistency_group_id and action are the required parameters
+# action can be failover, failback, restore, switchover, freeze, unfreeze, pause or resume
+# The replication consistency group is polled every poll_interval until it reaches the expected state, bounded by the timeouts
+# Destroying the resource leaves the replication consistency group in its current state
+
+# Fail over the group to the peer system during a DR drill, change action to "restore" or "failback" to end the drill
+resource "powerflex_replication_consistency_group_action" "dr_drill" {
+  replication_consistency_group_id = "a1b2c3d400000000"
+  action                           = "failover"
+  poll_interval                    = "30s"
+
+  timeouts {
+    create = "30m"
+    update = "30m"
+  }
+}
+
+output "replication_consistency_group_dr_drill" {
+  value = powerflex_replication_consistency_group_action.dr_drill
+}
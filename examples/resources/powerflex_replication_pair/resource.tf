@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Delete is supported for this resource, every change replaces the replication pair
+# To import , check import.sh for more info
+# replication_consistency_group_id and target_volume_id are the required parameters to create
+# To create, either source_volume_name or source_volume_id must be provided
+# The target volume is on the peer system, it can only be given by its ID
+# Destroying the replication pair keeps both volumes
+
+resource "powerflex_replication_pair" "app_data" {
+  name                             = "app_data"
+  replication_consistency_group_id = "a1b2c3d400000000"
+  source_volume_name               = "app_data"
+  target_volume_id                 = "b0b0b0b000000001"
+  copy_type                        = "OnlineCopy"
+}
+
+resource "powerflex_replication_pair" "app_logs" {
+  replication_consistency_group_id = "a1b2c3d400000000"
+  source_volume_id                 = "edb2a2cb00000002"
+  target_volume_id                 = "b0b0b0b000000002"
+}
+
+output "replication_pair_app_data" {
+  value = powerflex_replication_pair.app_data
+}
@@ -0,0 +1,8 @@
+# Below are the steps to import SDC approval :
+# Step 1 - To import an SDC approval , we need the id of that SDC
+# Step 2 - create a tf file with empty resource block . Refer the example below.
+# Example :
+# resource "powerflex_sdc_approval" "resource_block_name" {
+# }
+# Step 3 - execute the command: terraform import "powerflex_sdc_approval.resource_block_name" "id_of_the_sdc" (resource_block_name must be taken from step 2)
+# Step 4 - After successful execution of the command , check the state file
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import , check import.sh for more info
+# To approve an SDC, either sdc_guid (Guid restricted SDC mode) or sdc_ip (ApprovedIp restricted SDC mode) must be provided
+# SDCs which are not connected yet are pre-approved, so that new hosts can connect
+# An SDC which is already approved must be imported instead
+# only the name of the SDC can be updated
+# Deleting the resource removes the SDC, which revokes its approval
+
+resource "powerflex_sdc_approval" "host1" {
+  sdc_guid = "6F1D1A70-3A1E-4D26-9E24-0B2D8C1F0001"
+  name     = "host1"
+}
+
+resource "powerflex_sdc_approval" "host2" {
+  sdc_ip = "10.10.10.2"
+  name   = "host2"
+}
+
+output "sdc_approval_host1" {
+  value = powerflex_sdc_approval.host1
+}
@@ -21,6 +21,7 @@ limitations under the License.
 # To create / update, either protection_domain_name or protection_domain_id must be provided
 # name and ip_list are the required parameters to create or update
 # other  atrributes like : performance_profile, port, drl_mode, rmcache_enabled, rfcache_enabled, rmcache_size_in_mb are optional 
+# the SDS can be placed in a fault set of its protection domain at creation with either fault_set_id or fault_set_name
 # To check which attributes can be updated, please refer Product Guide in the documentation
 
 resource "powerflex_sds" "create" {
@@ -38,6 +39,18 @@ resource "powerflex_sds" "create" {
   ]
 }
 
+resource "powerflex_sds" "rack1" {
+  name                   = "demo-sds-rack1-01"
+  protection_domain_name = "demo-sds-pd"
+  fault_set_name         = "rack1"
+  ip_list = [
+    {
+      ip   = "10.10.10.13"
+      role = "all"
+    },
+  ]
+}
+
 output "changed_sds" {
   value = powerflex_sds.create
 }
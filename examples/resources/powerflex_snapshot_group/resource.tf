@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Delete is supported for this resource, any change of the attributes replaces the snapshot group
+# To import , check import.sh for more info
+# To create, either volume_ids or volume_names must be provided
+# other attributes like : name_prefix, access_mode are optional
+
+# crash-consistent snapshots of the volumes of a database
+resource "powerflex_snapshot_group" "database" {
+  volume_names = ["db-data", "db-logs"]
+  name_prefix  = "nightly-"
+}
+
+resource "powerflex_snapshot_group" "snapshot-group" {
+  volume_ids  = ["4577c84000000120", "4577c84100000121"]
+  access_mode = "ReadWrite"
+}
+
+output "database_snapshots" {
+  value = powerflex_snapshot_group.database.snapshots
+}
@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import , check import.sh for more info
+# name, auto_snapshot_creation_cadence_in_min and num_of_retained_snapshots_per_level are the required parameters to create or update
+# other attributes like : paused, secure_snapshots, snapshot_access_mode, volume_ids, remove_mode are optional
+# secure_snapshots and snapshot_access_mode cannot be updated
+
+# an auto snapshot every hour, retaining the last 24 hourly snapshots and 7 daily ones
+resource "powerflex_snapshot_policy" "hourly" {
+  name                                  = "hourly-policy"
+  auto_snapshot_creation_cadence_in_min = 60
+  num_of_retained_snapshots_per_level   = [24, 7]
+  volume_ids                            = ["4577c84000000120", "4577c84100000121"]
+}
+
+resource "powerflex_snapshot_policy" "paused" {
+  name                                  = "paused-policy"
+  auto_snapshot_creation_cadence_in_min = 1440
+  num_of_retained_snapshots_per_level   = [7]
+  paused                                = true
+  secure_snapshots                      = true
+  snapshot_access_mode                  = "ReadWrite"
+  remove_mode                           = "Remove"
+}
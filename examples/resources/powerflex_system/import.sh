@@ -0,0 +1,8 @@
+# Below are the steps to import system :
+# Step 1 - To import the settings of a system , we need the id of that system
+# Step 2 - create a tf file with empty resource block . Refer the example below.
+# Example :
+# resource "powerflex_system" "resource_block_name" {
+# }
+# Step 3 - execute the command: terraform import "powerflex_system.resource_block_name" "id_of_the_system" (resource_block_name must be taken from step 2)
+# Step 4 - After successful execution of the command , check the state file
@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import , check import.sh for more info
+# The resource manages the settings of the system configured on the provider, only one is needed
+# The settings which are not configured are left unchanged
+# Deleting the resource only removes it from the state, the settings of the system are not changed
+# The approved IPs of the SDCs removed from sdc_approved_ips are removed, the SDCs which were never listed are left unchanged
+
+resource "powerflex_system" "settings" {
+  restricted_sdc_mode = "ApprovedIp"
+  sdc_approved_ips = [
+    {
+      sdc_id = "e3d01ba100000000"
+      ips    = ["10.10.10.1", "10.10.20.1"]
+    },
+  ]
+  capacity_alert_high_threshold     = 80
+  capacity_alert_critical_threshold = 90
+  cli_password_allowed              = false
+}
+
+output "system_settings" {
+  value = powerflex_system.settings
+}
@@ -0,0 +1,9 @@
+# Below are the steps to import user :
+# Step 1 - To import a user , we need the id of that user
+# Step 2 - create a tf file with empty resource block . Refer the example below.
+# Example :
+# resource "powerflex_user" "resource_block_name" {
+# }
+# Step 3 - execute the command: terraform import "powerflex_user.resource_block_name" "id_of_the_user" (resource_block_name must be taken from step 2)
+# Step 4 - After successful execution of the command , check the state file
+# The password is not imported, the one configured afterwards is only saved in the state
@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import , check import.sh for more info
+# name, role and password are the required parameters to create or update
+# the name of the user cannot be updated
+# new users have to change their password at the first login
+# updating the password resets it, the user has to change it at the next login
+
+resource "powerflex_user" "monitor" {
+  name     = "monitor1"
+  role     = "Monitor"
+  password = "Password123!"
+}
+
+resource "powerflex_user" "admin" {
+  name     = "admin2"
+  role     = "Administrator"
+  password = "Password123!"
+}
+
+output "user_monitor" {
+  value     = powerflex_user.monitor
+  sensitive = true
+}
@@ -22,6 +22,8 @@ limitations under the License.
 # Also , to create / update, either protection_domain_id or protection_domain_name must be provided
 # name, size is the required parameter to create or update
 # other  atrributes like : capacity_unit, volume_type, use_rm_cache, compression_method, access_mode, remove_mode are optional 
+# changing the storage pool or the volume_type migrates the volume, with its snapshots, online; the update waits for the migration to complete
+# system_id is optional and only needed when the gateway manages more than one PowerFlex system and the volume must be created on a system other than the one configured on the provider
 # To check which attributes of the snapshot can be updated, please refer Product Guide in the documentation
 
 
@@ -47,4 +49,5 @@ resource "powerflex_volume" "avengers-volume-create" {
 # 	volume_type = "<ThickProvisioned/ThinProvisioned volume type>" 
 # 	access_mode = "<ReadWrite/ReadOnly volume access mode>"
 # 	compression_method = "<None/Normal compression method>"
+# 	system_id = "<ID of the PowerFlex system>"
 # }
\ No newline at end of file
@@ -0,0 +1,8 @@
+# Below are the steps to import volume clone :
+# Step 1 - To import a volume clone , we need the id of that volume clone
+# Step 2 - create a tf file with empty resource block . Refer the example below.
+# Example :
+# resource "powerflex_volume_clone" "resource_block_name" {
+# }
+# Step 3 - execute the command: terraform import "powerflex_volume_clone.resource_block_name" "id_of_the_volume_clone" (resource_block_name must be taken from step 2)
+# Step 4 - After successful execution of the command , check the state file
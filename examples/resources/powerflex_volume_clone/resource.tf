@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import , check import.sh for more info
+# name is the required parameter to create
+# To create, either source_volume_id or source_snapshot_id must be provided
+# the clone has the size of its source unless size is set, it can only be increased in multiples of 8GB
+# name, size and access_mode can be updated, changing the source replaces the clone
+
+# clone of a snapshot, to refresh a test volume from a snapshot of the production volume
+resource "powerflex_volume_clone" "from_snapshot" {
+  name               = "test_refresh"
+  source_snapshot_id = "5a5c9f5b00000003"
+  size               = 16
+  capacity_unit      = "GB"
+  access_mode        = "ReadWrite"
+}
+
+# clone of a volume, with the size of the volume
+resource "powerflex_volume_clone" "from_volume" {
+  name             = "dev_copy"
+  source_volume_id = "edb2059700000002"
+}
+
+output "volume_clone_from_snapshot" {
+  value = powerflex_volume_clone.from_snapshot
+}
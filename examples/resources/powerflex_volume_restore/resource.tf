@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create and Update overwrite the content of the target volume with the content of the snapshot, import is not supported
+# target_volume_id and source_snapshot_id are the required parameters
+# The snapshot must belong to the VTree of the target volume
+# Changing source_snapshot_id or trigger restores the target volume again, changing target_volume_id replaces the resource
+# Destroying the resource leaves the volume with its current content
+
+# Refresh the test volume from its golden snapshot, set trigger to a new value for each refresh
+resource "powerflex_volume_restore" "refresh" {
+  target_volume_id   = "edb2059700000002"
+  source_snapshot_id = "edb2059800000003"
+  trigger            = "2023-06-01T08:00:00Z"
+}
+
+output "volume_restore_refresh" {
+  value = powerflex_volume_restore.refresh
+}
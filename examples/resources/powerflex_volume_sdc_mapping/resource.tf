@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import , check import.sh for more info
+# To create/update, either volume_id or volume_name must be provided
+# Each SDC of sdc_list is given by exactly one of sdc_id, sdc_name, sdc_ip and sdc_guid
+# The resource manages all the mappings of the volume, the SDCs mapped to the volume outside of the resource are unmapped
+# Changing the volume replaces the resource
+
+# datastore volume shared by the SDCs of an ESXi cluster
+resource "powerflex_volume_sdc_mapping" "datastore" {
+  volume_name = "esxi_datastore"
+  sdc_list = [
+    {
+      sdc_id      = "e3ce1fb600000001"
+      access_mode = "ReadWrite"
+    },
+    {
+      sdc_name    = "esxi-host-2"
+      access_mode = "ReadWrite"
+    },
+    {
+      sdc_ip           = "10.10.10.13"
+      access_mode      = "ReadWrite"
+      limit_iops       = 140
+      limit_bw_in_mbps = 19
+    },
+    {
+      sdc_guid    = "6F1D1A70-3A1E-4D26-9E24-0B2D8C1F0004"
+      access_mode = "ReadOnly"
+    }
+  ]
+}
+
+# To unmap the volume from all the SDCs, below config can be used.
+
+resource "powerflex_volume_sdc_mapping" "unmapped" {
+  volume_id = "edb2059700000002"
+  sdc_list  = []
+}
@@ -3,7 +3,7 @@ module terraform-provider-powerflex
 go 1.19
 
 require (
-	github.com/dell/goscaleio v1.10.1-0.20230612061006-3506e42901fe
+	github.com/dell/goscaleio v1.10.1-0.20230612061006-3506e42901fe // pinned: the client package reads unexported fields of goscaleio
 	github.com/hashicorp/terraform-plugin-docs v0.13.0
 	github.com/hashicorp/terraform-plugin-framework v1.1.1
 	github.com/hashicorp/terraform-plugin-framework-validators v0.9.0
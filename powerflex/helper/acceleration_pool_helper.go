@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpdateAccelerationPoolState saves the acceleration pool in the resource state
+func UpdateAccelerationPoolState(pool *client.AccelerationPool, state *models.AccelerationPoolResourceModel) {
+	state.ID = types.StringValue(pool.ID)
+	state.Name = types.StringValue(pool.Name)
+	state.ProtectionDomainID = types.StringValue(pool.ProtectionDomainID)
+	state.MediaType = types.StringValue(pool.MediaType)
+}
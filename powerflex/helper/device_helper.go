@@ -44,7 +44,15 @@ func UpdateDeviceState(deviceResponse *goscaleio_types.Device, plan models.Devic
 	state.DeviceCapacityInKB = types.Int64Value(int64(deviceResponse.CapacityLimitInKb))
 	state.DeviceState = types.StringValue(deviceResponse.DeviceState)
 	state.SdsID = types.StringValue(deviceResponse.SdsID)
-	state.StoragePoolID = types.StringValue(deviceResponse.StoragePoolID)
+	// a device belongs either to a storage pool or, as an acceleration device, to an acceleration pool
+	state.StoragePoolID = types.StringNull()
+	if deviceResponse.StoragePoolID != "" {
+		state.StoragePoolID = types.StringValue(deviceResponse.StoragePoolID)
+	}
+	state.AccelerationPoolID = types.StringNull()
+	if deviceResponse.AccelerationPoolID != "" {
+		state.AccelerationPoolID = types.StringValue(deviceResponse.AccelerationPoolID)
+	}
 	return state, diags
 }
 
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/models"
+
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpdateFaultSetState saves the fault set in the resource state
+func UpdateFaultSetState(faultSet *client.FaultSet, state *models.FaultSetResourceModel) {
+	state.ID = types.StringValue(faultSet.ID)
+	state.Name = types.StringValue(faultSet.Name)
+	state.ProtectionDomainID = types.StringValue(faultSet.ProtectionDomainID)
+}
+
+// GetFaultSetState returns the data source state of the fault set and of its SDSs
+func GetFaultSetState(faultSet *client.FaultSet, sdsList []scaleiotypes.Sds) models.FaultSetModel {
+	faultSetState := models.FaultSetModel{
+		ID:                 types.StringValue(faultSet.ID),
+		Name:               types.StringValue(faultSet.Name),
+		ProtectionDomainID: types.StringValue(faultSet.ProtectionDomainID),
+		SdsIDs:             []types.String{},
+	}
+	for _, sds := range sdsList {
+		faultSetState.SdsIDs = append(faultSetState.SdsIDs, types.StringValue(sds.ID))
+	}
+	return faultSetState
+}
@@ -19,7 +19,10 @@ package helper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
 
 	"bytes"
 	"encoding/json"
@@ -32,20 +35,56 @@ import (
 
 // GetFirstSystem - finds available first system and returns it.
 func GetFirstSystem(rc *goscaleio.Client) (*goscaleio.System, error) {
+	return GetSystem(rc, "", "")
+}
+
+// GetSystem - finds the system with the given ID or name and returns it.
+// When neither is given, the only system managed by the gateway is returned.
+func GetSystem(rc *goscaleio.Client, systemID, systemName string) (*goscaleio.System, error) {
 	allSystems, err := rc.GetSystems()
 	if err != nil {
 		return nil, fmt.Errorf("Error in goscaleio GetSystems")
 	}
 	if numSys := len((allSystems)); numSys == 0 {
 		return nil, fmt.Errorf("no systems found")
-	} else if numSys > 1 {
-		return nil, fmt.Errorf("more than one system found")
+	} else if numSys > 1 && systemID == "" && systemName == "" {
+		return nil, fmt.Errorf("more than one system found, please set system_id or system_name to choose one of them")
 	}
-	system, err := rc.FindSystem(allSystems[0].ID, "", "")
-	if err != nil {
-		return nil, fmt.Errorf("Error in goscaleio FindSystem")
+	for _, sys := range allSystems {
+		if (systemID == "" || sys.ID == systemID) && (systemName == "" || sys.Name == systemName) {
+			system := goscaleio.NewSystem(rc)
+			system.System = sys
+			return system, nil
+		}
+	}
+	if systemID != "" {
+		return nil, fmt.Errorf("system with ID %s not found", systemID)
+	}
+	return nil, fmt.Errorf("system with name %s not found", systemName)
+}
+
+// SystemIDOrDefault returns the system ID set on a resource, falling back to
+// the one configured on the provider when it is not set.
+func SystemIDOrDefault(systemID types.String, defaultSystemID string) string {
+	if systemID.IsNull() || systemID.IsUnknown() || systemID.ValueString() == "" {
+		return defaultSystemID
 	}
-	return system, nil
+	return systemID.ValueString()
+}
+
+// notFoundMessage matches the messages returned by PowerFlex and goscaleio when an object does not exist.
+var notFoundMessage = regexp.MustCompile(`(?i)(could not find|couldn't find|not found|does not exist)`)
+
+// IsNotFoundError - returns true when the error reports that the requested object does not exist on PowerFlex.
+func IsNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *scaleiotypes.Error
+	if errors.As(err, &apiErr) && apiErr.HTTPStatusCode == http.StatusNotFound {
+		return true
+	}
+	return notFoundMessage.MatchString(err.Error())
 }
 
 // PrettyJSON - function for logging json readable output.
@@ -62,8 +101,8 @@ func PrettyJSON(data interface{}) string {
 }
 
 // GetNewProtectionDomainEx function to get Protection Domain
-func GetNewProtectionDomainEx(c *goscaleio.Client, pdID string, pdName string, href string) (*goscaleio.ProtectionDomain, error) {
-	system, err := GetFirstSystem(c)
+func GetNewProtectionDomainEx(c *goscaleio.Client, systemID string, pdID string, pdName string, href string) (*goscaleio.ProtectionDomain, error) {
+	system, err := GetSystem(c, systemID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -85,8 +124,8 @@ func GetNewProtectionDomainEx(c *goscaleio.Client, pdID string, pdName string, h
 }
 
 // GetStoragePoolType returns storage pool type
-func GetStoragePoolType(r *goscaleio.Client, storagePoolID string) (*goscaleio.StoragePool, error) {
-	system, err := GetFirstSystem(r)
+func GetStoragePoolType(r *goscaleio.Client, systemID string, storagePoolID string) (*goscaleio.StoragePool, error) {
+	system, err := GetSystem(r, systemID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +140,8 @@ func GetStoragePoolType(r *goscaleio.Client, storagePoolID string) (*goscaleio.S
 }
 
 // GetSdcType function returns SDC type
-func GetSdcType(c *goscaleio.Client, sdcID string) (*goscaleio.Sdc, error) {
-	system, err := GetFirstSystem(c)
+func GetSdcType(c *goscaleio.Client, systemID string, sdcID string) (*goscaleio.Sdc, error) {
+	system, err := GetSystem(c, systemID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +154,9 @@ func GetVolumeType(c *goscaleio.Client, volID string) (*goscaleio.Volume, error)
 	if err != nil {
 		return nil, err
 	}
+	if len(volumes) == 0 {
+		return nil, fmt.Errorf("volume with ID %s not found", volID)
+	}
 
 	volume := volumes[0]
 	volType := goscaleio.NewVolume(c)
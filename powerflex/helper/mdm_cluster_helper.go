@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// MdmAttrTypes are the attribute types of a member of the MDM cluster
+var MdmAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"name":           types.StringType,
+	"port":           types.Int64Type,
+	"ips":            types.ListType{ElemType: types.StringType},
+	"management_ips": types.ListType{ElemType: types.StringType},
+	"role":           types.StringType,
+	"status":         types.StringType,
+}
+
+// ClusterModeSecondaryMdms maps the modes of the MDM cluster to the number of secondary MDMs and tie-breakers they require
+var ClusterModeSecondaryMdms = map[string]int{
+	client.ClusterModeOneNode:    0,
+	client.ClusterModeThreeNodes: 1,
+	client.ClusterModeFiveNodes:  2,
+}
+
+// GetMdmClusterMembers returns all the members of the MDM cluster, whatever their role
+func GetMdmClusterMembers(cluster *client.MdmCluster) []*client.Mdm {
+	members := []*client.Mdm{}
+	if cluster.PrimaryMdm != nil {
+		members = append(members, cluster.PrimaryMdm)
+	}
+	members = append(members, cluster.SecondaryMdms...)
+	members = append(members, cluster.TieBreakers...)
+	return append(members, cluster.StandbyMdms...)
+}
+
+// FindMdm returns the member of the MDM cluster matching the MDM of the plan by its ID, or by one of its IPs
+func FindMdm(ctx context.Context, cluster *client.MdmCluster, mdm models.MdmModel) *client.Mdm {
+	ips := GetMdmIPs(ctx, mdm.IPs)
+	for _, member := range GetMdmClusterMembers(cluster) {
+		if !mdm.ID.IsUnknown() && mdm.ID.ValueString() != "" {
+			if member.ID == mdm.ID.ValueString() {
+				return member
+			}
+			continue
+		}
+		for _, ip := range member.IPs {
+			for _, planned := range ips {
+				if ip == planned {
+					return member
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// GetMdmIPs returns the IPs of a list of the plan, an unknown list has no IPs
+func GetMdmIPs(ctx context.Context, list types.List) []string {
+	ips := []string{}
+	if list.IsNull() || list.IsUnknown() {
+		return ips
+	}
+	list.ElementsAs(ctx, &ips, false)
+	return ips
+}
+
+// GetMdmModels returns the MDMs of a set of the plan, an unknown set is not managed and has no MDM
+func GetMdmModels(ctx context.Context, set types.Set) ([]models.MdmModel, diag.Diagnostics) {
+	mdms := []models.MdmModel{}
+	if set.IsNull() || set.IsUnknown() {
+		return mdms, nil
+	}
+	diags := set.ElementsAs(ctx, &mdms, false)
+	return mdms, diags
+}
+
+// GetPrimaryMdmModel returns the primary MDM of the plan
+func GetPrimaryMdmModel(ctx context.Context, object types.Object) (models.MdmModel, diag.Diagnostics) {
+	var mdm models.MdmModel
+	diags := object.As(ctx, &mdm, basetypes.ObjectAsOptions{})
+	return mdm, diags
+}
+
+// mdmObject converts a member of the MDM cluster to its terraform value
+func mdmObject(ctx context.Context, mdm *client.Mdm) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ips, dgs := types.ListValueFrom(ctx, types.StringType, mdm.IPs)
+	diags = append(diags, dgs...)
+	managementIPs := mdm.ManagementIPs
+	if managementIPs == nil {
+		managementIPs = []string{}
+	}
+	mgmtIPs, dgs := types.ListValueFrom(ctx, types.StringType, managementIPs)
+	diags = append(diags, dgs...)
+	obj, dgs := types.ObjectValue(MdmAttrTypes, map[string]attr.Value{
+		"id":             types.StringValue(mdm.ID),
+		"name":           types.StringValue(mdm.Name),
+		"port":           types.Int64Value(int64(mdm.Port)),
+		"ips":            ips,
+		"management_ips": mgmtIPs,
+		"role":           types.StringValue(mdm.Role),
+		"status":         types.StringValue(mdm.Status),
+	})
+	diags = append(diags, dgs...)
+	return obj, diags
+}
+
+// mdmSet converts members of the MDM cluster to a terraform set
+func mdmSet(ctx context.Context, mdms []*client.Mdm) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	objects := []attr.Value{}
+	for _, mdm := range mdms {
+		obj, dgs := mdmObject(ctx, mdm)
+		diags = append(diags, dgs...)
+		objects = append(objects, obj)
+	}
+	set, dgs := types.SetValue(types.ObjectType{AttrTypes: MdmAttrTypes}, objects)
+	diags = append(diags, dgs...)
+	return set, diags
+}
+
+// UpdateMdmClusterState saves the topology of the MDM cluster in the resource state
+func UpdateMdmClusterState(ctx context.Context, cluster *client.MdmCluster, state *models.MdmClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	state.ID = types.StringValue(cluster.ID)
+	state.ClusterMode = types.StringValue(cluster.ClusterMode)
+	state.ClusterState = types.StringValue(cluster.ClusterState)
+
+	primary := &client.Mdm{}
+	if cluster.PrimaryMdm != nil {
+		primary = cluster.PrimaryMdm
+	}
+	var dgs diag.Diagnostics
+	state.PrimaryMdm, dgs = mdmObject(ctx, primary)
+	diags = append(diags, dgs...)
+	state.SecondaryMdm, dgs = mdmSet(ctx, cluster.SecondaryMdms)
+	diags = append(diags, dgs...)
+	state.TieBreakerMdm, dgs = mdmSet(ctx, cluster.TieBreakers)
+	diags = append(diags, dgs...)
+	state.StandbyMdm, dgs = mdmSet(ctx, cluster.StandbyMdms)
+	diags = append(diags, dgs...)
+	return diags
+}
+
+// GetMdmClusterState returns the topology of the MDM cluster for the data source
+func GetMdmClusterState(cluster *client.MdmCluster) models.MdmClusterDataSourceModel {
+	state := models.MdmClusterDataSourceModel{
+		ID:              types.StringValue(cluster.ID),
+		Name:            types.StringValue(cluster.Name),
+		ClusterMode:     types.StringValue(cluster.ClusterMode),
+		ClusterState:    types.StringValue(cluster.ClusterState),
+		GoodNodesNum:    types.Int64Value(int64(cluster.GoodNodesNum)),
+		GoodReplicasNum: types.Int64Value(int64(cluster.GoodReplicasNum)),
+		SecondaryMdm:    getMdmDataModels(cluster.SecondaryMdms),
+		TieBreakerMdm:   getMdmDataModels(cluster.TieBreakers),
+		StandbyMdm:      getMdmDataModels(cluster.StandbyMdms),
+	}
+	if cluster.PrimaryMdm != nil {
+		primary := getMdmDataModel(cluster.PrimaryMdm)
+		state.PrimaryMdm = &primary
+	}
+	return state
+}
+
+// getMdmDataModels converts members of the MDM cluster for the data source
+func getMdmDataModels(mdms []*client.Mdm) []models.MdmDataModel {
+	list := []models.MdmDataModel{}
+	for _, mdm := range mdms {
+		list = append(list, getMdmDataModel(mdm))
+	}
+	return list
+}
+
+// getMdmDataModel converts a member of the MDM cluster for the data source
+func getMdmDataModel(mdm *client.Mdm) models.MdmDataModel {
+	model := models.MdmDataModel{
+		ID:            types.StringValue(mdm.ID),
+		Name:          types.StringValue(mdm.Name),
+		Port:          types.Int64Value(int64(mdm.Port)),
+		IPs:           []types.String{},
+		ManagementIPs: []types.String{},
+		Role:          types.StringValue(mdm.Role),
+		Status:        types.StringValue(mdm.Status),
+		VersionInfo:   types.StringValue(mdm.VersionInfo),
+	}
+	for _, ip := range mdm.IPs {
+		model.IPs = append(model.IPs, types.StringValue(ip))
+	}
+	for _, ip := range mdm.ManagementIPs {
+		model.ManagementIPs = append(model.ManagementIPs, types.StringValue(ip))
+	}
+	return model
+}
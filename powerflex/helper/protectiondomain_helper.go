@@ -36,6 +36,8 @@ func GetPDResState(protectionDomain *scaleiotypes.ProtectionDomain) models.Prote
 		Active: types.BoolValue(protectionDomain.ProtectionDomainState == "Active"),
 		State:  types.StringValue(protectionDomain.ProtectionDomainState),
 
+		SystemID: types.StringValue(protectionDomain.SystemID),
+
 		// Network throttling params
 		RebuildNetworkThrottlingInKbps:                  types.Int64Value(int64(protectionDomain.RebuildNetworkThrottlingInKbps)),
 		RebalanceNetworkThrottlingInKbps:                types.Int64Value(int64(protectionDomain.RebalanceNetworkThrottlingInKbps)),
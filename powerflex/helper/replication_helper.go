@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetPeerSystemIPs returns the IPs of the remote MDM in the plan
+func GetPeerSystemIPs(ctx context.Context, plan models.PeerSystemResourceModel) ([]string, diag.Diagnostics) {
+	var ips []string
+	diags := plan.IPList.ElementsAs(ctx, &ips, true)
+	return ips, diags
+}
+
+// UpdatePeerSystemState saves the peer MDM in the resource state
+func UpdatePeerSystemState(peerMdm *client.PeerMdm, state *models.PeerSystemResourceModel) diag.Diagnostics {
+	state.ID = types.StringValue(peerMdm.ID)
+	state.Name = types.StringValue(peerMdm.Name)
+	state.RemoteSystemID = types.StringValue(peerMdm.PeerSystemID)
+	state.Port = types.Int64Value(int64(peerMdm.Port))
+	state.PerformanceProfile = types.StringValue(peerMdm.PerfProfile)
+	state.SystemID = types.StringValue(peerMdm.SystemID)
+	state.SoftwareVersionInfo = types.StringValue(peerMdm.SoftwareVersionInfo)
+	state.MembershipState = types.StringValue(peerMdm.MembershipState)
+	state.CouplingRC = types.StringValue(peerMdm.CouplingRC)
+
+	ips := []attr.Value{}
+	for _, ip := range peerMdm.IPList {
+		ips = append(ips, types.StringValue(ip.IP))
+	}
+	listVal, diags := types.ListValue(types.StringType, ips)
+	state.IPList = listVal
+	return diags
+}
+
+// UpdateReplicationConsistencyGroupState saves the replication consistency group in the resource state
+func UpdateReplicationConsistencyGroupState(rcg *scaleiotypes.ReplicationConsistencyGroup, state *models.ReplicationConsistencyGroupResourceModel) {
+	state.ID = types.StringValue(rcg.ID)
+	state.Name = types.StringValue(rcg.Name)
+	state.PeerSystemID = types.StringValue(rcg.PeerMdmID)
+	state.ProtectionDomainID = types.StringValue(rcg.ProtectionDomainID)
+	state.RemoteProtectionDomainID = types.StringValue(rcg.RemoteProtectionDomainID)
+	state.RpoInSeconds = types.Int64Value(int64(rcg.RpoInSeconds))
+	state.TargetVolumeAccessMode = types.StringValue(rcg.TargetVolumeAccessMode)
+	state.Active = types.BoolValue(rcg.LocalActivityState != "Inactive")
+	state.Paused = types.BoolValue(rcg.PauseMode != "" && rcg.PauseMode != client.PauseModeNone)
+	state.DestinationSystemID = types.StringValue(rcg.DestinationSystemID)
+	state.RemoteID = types.StringValue(rcg.RemoteID)
+	state.ReplicationDirection = types.StringValue(rcg.ReplicationDirection)
+	state.CurrConsistMode = types.StringValue(rcg.CurrConsistMode)
+}
+
+// GetReplicationPair returns a replication pair by its ID, goscaleio only lists the replication pairs
+func GetReplicationPair(c *goscaleio.Client, id string) (*scaleiotypes.ReplicationPair, error) {
+	pairs, err := c.GetAllReplicationPairs()
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range pairs {
+		if pair.ID == id {
+			return pair, nil
+		}
+	}
+	return nil, fmt.Errorf("could not find the replication pair with ID %s", id)
+}
+
+// UpdateReplicationPairState saves the replication pair and the progress of its initial copy in the resource state
+func UpdateReplicationPairState(pair *scaleiotypes.ReplicationPair, stats *scaleiotypes.QueryReplicationPairStatistics, state *models.ReplicationPairResourceModel) {
+	state.ID = types.StringValue(pair.ID)
+	state.Name = types.StringValue(pair.Name)
+	state.ReplicationConsistencyGroupID = types.StringValue(pair.ReplicationConsistencyGroupID)
+	state.SourceVolumeID = types.StringValue(pair.LocalVolumeID)
+	state.TargetVolumeID = types.StringValue(pair.RemoteVolumeID)
+	state.TargetVolumeName = types.StringValue(pair.RemoteVolumeName)
+	state.CopyType = types.StringValue(pair.CopyType)
+	state.RemoteID = types.StringValue(pair.RemoteID)
+	state.PeerSystemName = types.StringValue(pair.PeerSystemName)
+	state.LifetimeState = types.StringValue(pair.LifetimeState)
+	state.InitialCopyState = types.StringValue(pair.InitialCopyState)
+	state.InitialCopyProgress = types.Float64Value(stats.InitialCopyProgress)
+}
+
+// Actions of the replication consistency group action resource
+const (
+	RcgActionFailover   = "failover"
+	RcgActionFailback   = "failback"
+	RcgActionRestore    = "restore"
+	RcgActionSwitchover = "switchover"
+	RcgActionFreeze     = "freeze"
+	RcgActionUnfreeze   = "unfreeze"
+	RcgActionPause      = "pause"
+	RcgActionResume     = "resume"
+)
+
+// DefaultRcgActionPollInterval is the interval at which the replication consistency group is polled after an action
+const DefaultRcgActionPollInterval = "10s"
+
+// ReplicationConsistencyGroupActionDone reports whether the replication consistency group reached the state expected after the action.
+// The failback is done once the replication direction differs from the direction before the action.
+func ReplicationConsistencyGroupActionDone(action string, rcg *scaleiotypes.ReplicationConsistencyGroup, directionBefore string) bool {
+	switch action {
+	case RcgActionFailover:
+		return rcg.FailoverType == client.FailoverTypeFailover && rcg.FailoverState == client.FailoverStateDone
+	case RcgActionSwitchover:
+		return rcg.FailoverType == client.FailoverTypeSwitchover && rcg.FailoverState == client.FailoverStateDone
+	case RcgActionRestore:
+		return rcg.FailoverType == client.FailoverTypeNone
+	case RcgActionFailback:
+		return rcg.FailoverType == client.FailoverTypeNone && rcg.ReplicationDirection != directionBefore
+	case RcgActionFreeze:
+		return rcg.FreezeState == client.FreezeStateFrozen
+	case RcgActionUnfreeze:
+		return rcg.FreezeState == client.FreezeStateUnfrozen
+	case RcgActionPause:
+		return rcg.PauseMode != "" && rcg.PauseMode != client.PauseModeNone
+	case RcgActionResume:
+		return rcg.PauseMode == client.PauseModeNone
+	}
+	return false
+}
+
+// UpdateReplicationConsistencyGroupActionState saves the state of the replication consistency group in the action resource state
+func UpdateReplicationConsistencyGroupActionState(rcg *scaleiotypes.ReplicationConsistencyGroup, state *models.ReplicationConsistencyGroupActionResourceModel) {
+	state.ID = types.StringValue(rcg.ID)
+	state.ReplicationConsistencyGroupID = types.StringValue(rcg.ID)
+	state.FailoverType = types.StringValue(rcg.FailoverType)
+	state.FailoverState = types.StringValue(rcg.FailoverState)
+	state.FreezeState = types.StringValue(rcg.FreezeState)
+	state.PauseMode = types.StringValue(rcg.PauseMode)
+	state.ReplicationDirection = types.StringValue(rcg.ReplicationDirection)
+	state.DisasterRecoveryState = types.StringValue(rcg.DisasterRecoveryState)
+}
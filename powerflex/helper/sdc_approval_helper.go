@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/models"
+
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpdateSdcApprovalState saves the approved SDC in the resource state.
+// The SDC is identified by its GUID or by its IP, after an import the one matching the restricted SDC mode is saved.
+func UpdateSdcApprovalState(sdc *scaleiotypes.Sdc, restrictedSdcMode string, state *models.SdcApprovalResourceModel) {
+	state.ID = types.StringValue(sdc.ID)
+	state.Name = types.StringValue(sdc.Name)
+	state.SdcApproved = types.BoolValue(sdc.SdcApproved)
+	state.MdmConnectionState = types.StringValue(sdc.MdmConnectionState)
+	if state.SdcGUID.IsNull() && state.SdcIP.IsNull() {
+		if restrictedSdcMode == client.RestrictedSdcModeApprovedIP {
+			state.SdcIP = types.StringValue(sdc.SdcIP)
+		} else {
+			state.SdcGUID = types.StringValue(sdc.SdcGUID)
+		}
+	}
+}
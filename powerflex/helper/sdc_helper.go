@@ -314,7 +314,10 @@ func ValidateMDMOperation(ctx context.Context, model models.SdcResourceModel, ga
 	return validateMDMResponse, nil
 }
 
-// InstallationOperations function for begin instllation process
+// InstallerPollInterval is the interval at which the gateway installer is polled for the completion of a phase
+const InstallerPollInterval = 1 * time.Minute
+
+// InstallationOperations function for begin instllation process, it polls the installer until the deadline of ctx
 func InstallationOperations(ctx context.Context, model models.SdcResourceModel, gatewayClient *goscaleio.GatewayClient, parsecsvRespose *goscaleio_types.GatewayResponse) error {
 
 	beginInstallationResponse, installationError := gatewayClient.BeginInstallation(parsecsvRespose.Data, "admin", model.MdmPassword.ValueString(), model.LiaPassword.ValueString(), true)
@@ -323,78 +326,68 @@ func InstallationOperations(ctx context.Context, model models.SdcResourceModel,
 		return fmt.Errorf("Error while begin installation is %s", installationError.Error())
 	}
 
-	if beginInstallationResponse.StatusCode == 200 {
-		currentPhase := "query"
-		couterForStopExecution := 0
+	if beginInstallationResponse.StatusCode != 200 {
+		return fmt.Errorf("Message: %s, Error Code: %s", beginInstallationResponse.Message, strconv.Itoa(beginInstallationResponse.StatusCode))
+	}
 
-		tflog.Info(ctx, "Gateway Installation Begin, Current Phase - Query")
+	currentPhase := "query"
 
-		for couterForStopExecution <= 5 {
+	tflog.Info(ctx, "Gateway Installation Begin, Current Phase - Query")
 
-			time.Sleep(1 * time.Minute)
+	for {
+		// the polling budget is bound by the deadline of the context, set from the timeouts of the resource
+		select {
+		case <-ctx.Done():
+			// to make gateway available for installation
+			queueOperationError := ResetInstallerQueue(gatewayClient)
+			if queueOperationError != nil {
+				return fmt.Errorf("Error Clearing Queue During Installation is %s", queueOperationError.Error())
+			}
 
-			checkForPhaseCompleted, _ := gatewayClient.CheckForCompletionQueueCommands(currentPhase)
+			return fmt.Errorf("Time Out,Some Operations of Installer running from since long")
+		case <-time.After(InstallerPollInterval):
+		}
 
-			if checkForPhaseCompleted.Data == "Completed" {
-				couterForStopExecution = 0
+		checkForPhaseCompleted, _ := gatewayClient.CheckForCompletionQueueCommands(currentPhase)
 
-				if currentPhase != "configure" {
-					moveToNextPhaseResponse, err := gatewayClient.MoveToNextPhase()
+		if checkForPhaseCompleted.Data == "Completed" {
+			if currentPhase != "configure" {
+				moveToNextPhaseResponse, err := gatewayClient.MoveToNextPhase()
 
-					if err != nil {
-						return fmt.Errorf("Error while moving to next phase is %s", err.Error())
-					}
+				if err != nil {
+					return fmt.Errorf("Error while moving to next phase is %s", err.Error())
+				}
 
-					if moveToNextPhaseResponse.StatusCode == 200 {
-						if currentPhase == "query" {
-							currentPhase = "upload"
-							tflog.Info(ctx, "Gateway Installation phase changed to Upload")
-						} else if currentPhase == "upload" {
-							currentPhase = "install"
-							tflog.Info(ctx, "Gateway Installation phase changed to Install")
-						} else if currentPhase == "install" {
-							currentPhase = "configure"
-							tflog.Info(ctx, "Gateway Installation phase changed to Configure")
-						}
-					} else {
-						return fmt.Errorf("Messsage: %s, Error Code: %s", moveToNextPhaseResponse.Message, strconv.Itoa(moveToNextPhaseResponse.StatusCode))
+				if moveToNextPhaseResponse.StatusCode == 200 {
+					if currentPhase == "query" {
+						currentPhase = "upload"
+						tflog.Info(ctx, "Gateway Installation phase changed to Upload")
+					} else if currentPhase == "upload" {
+						currentPhase = "install"
+						tflog.Info(ctx, "Gateway Installation phase changed to Install")
+					} else if currentPhase == "install" {
+						currentPhase = "configure"
+						tflog.Info(ctx, "Gateway Installation phase changed to Configure")
 					}
 				} else {
-					// to make gateway available for installation
-					queueOperationError := ResetInstallerQueue(gatewayClient)
-					if queueOperationError != nil {
-						return fmt.Errorf("Error Clearing Queue During Installation is %s", queueOperationError.Error())
-					}
-
-					couterForStopExecution = 10
-
-					return nil
+					return fmt.Errorf("Messsage: %s, Error Code: %s", moveToNextPhaseResponse.Message, strconv.Itoa(moveToNextPhaseResponse.StatusCode))
 				}
-
-			} else if checkForPhaseCompleted.Data == "Running" {
-				couterForStopExecution++
-
-				tflog.Info(ctx, "Gateway Installation operations are still running")
-
-				if couterForStopExecution == 5 {
-					// to make gateway available for installation
-					queueOperationError := ResetInstallerQueue(gatewayClient)
-					if queueOperationError != nil {
-						return fmt.Errorf("Error Clearing Queue During Installation is %s", queueOperationError.Error())
-					}
-
-					return fmt.Errorf("Time Out,Some Operations of Installer running from since long")
+			} else {
+				// to make gateway available for installation
+				queueOperationError := ResetInstallerQueue(gatewayClient)
+				if queueOperationError != nil {
+					return fmt.Errorf("Error Clearing Queue During Installation is %s", queueOperationError.Error())
 				}
 
-			} else {
-				return fmt.Errorf("Error During Installation is %s", checkForPhaseCompleted.Message)
+				return nil
 			}
+
+		} else if checkForPhaseCompleted.Data == "Running" {
+			tflog.Info(ctx, "Gateway Installation operations are still running")
+		} else {
+			return fmt.Errorf("Error During Installation is %s", checkForPhaseCompleted.Message)
 		}
-	} else {
-		return fmt.Errorf("Message: %s, Error Code: %s", beginInstallationResponse.Message, strconv.Itoa(beginInstallationResponse.StatusCode))
 	}
-
-	return nil
 }
 
 // CheckForNewSDCIPs function to check SDC Alredy Installed or not
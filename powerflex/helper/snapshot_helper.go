@@ -18,11 +18,13 @@ limitations under the License.
 package helper
 
 import (
+	"sort"
 	"strconv"
 
 	"terraform-provider-powerflex/powerflex/models"
 
 	pftypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -93,3 +95,58 @@ func ConverterKB(capacityUnit string, size int64) int64 {
 	}
 	return int64(valInKiB)
 }
+
+// GetSnapshotGroupMemberType returns the type of the snapshots of a snapshot group
+func GetSnapshotGroupMemberType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"volume_id":   types.StringType,
+		"volume_name": types.StringType,
+	}
+}
+
+// GetSnapshotGroupMembers returns the snapshots of the snapshot group among the snapshots, sorted by volume ID
+func GetSnapshotGroupMembers(groupID string, allSnapshots []*pftypes.Volume) []*pftypes.Volume {
+	snapshots := []*pftypes.Volume{}
+	for _, vol := range allSnapshots {
+		if vol.ConsistencyGroupID == groupID {
+			snapshots = append(snapshots, vol)
+		}
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].AncestorVolumeID < snapshots[j].AncestorVolumeID
+	})
+	return snapshots
+}
+
+// UpdateSnapshotGroupState saves the state of the snapshot group from its snapshots and the names of their volumes
+func UpdateSnapshotGroupState(snapshots []*pftypes.Volume, volumeNames map[string]string, state *models.SnapshotGroupResourceModel) (diags diag.Diagnostics) {
+	memberAttrTypes := GetSnapshotGroupMemberType()
+	members := []attr.Value{}
+	volumeIDs := []attr.Value{}
+	for _, snap := range snapshots {
+		obj := map[string]attr.Value{
+			"id":          types.StringValue(snap.ID),
+			"name":        types.StringValue(snap.Name),
+			"volume_id":   types.StringValue(snap.AncestorVolumeID),
+			"volume_name": types.StringValue(volumeNames[snap.AncestorVolumeID]),
+		}
+		objVal, dgs := types.ObjectValue(memberAttrTypes, obj)
+		diags = append(diags, dgs...)
+		members = append(members, objVal)
+		volumeIDs = append(volumeIDs, types.StringValue(snap.AncestorVolumeID))
+		state.AccessMode = types.StringValue(snap.AccessModeLimit)
+	}
+	listVal, dgs := types.ListValue(types.ObjectType{AttrTypes: memberAttrTypes}, members)
+	diags = append(diags, dgs...)
+	state.Snapshots = listVal
+
+	// the volumes are not known after an import
+	if state.VolumeIDs.IsNull() && state.VolumeNames.IsNull() {
+		setVal, dgs := types.SetValue(types.StringType, volumeIDs)
+		diags = append(diags, dgs...)
+		state.VolumeIDs = setVal
+	}
+	return diags
+}
@@ -18,9 +18,14 @@ limitations under the License.
 package helper
 
 import (
+	"context"
+	"strconv"
+
 	"terraform-provider-powerflex/powerflex/models"
 
 	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -61,3 +66,49 @@ func UpdateSnapshotPolicyState(sps []*scaleiotypes.SnapshotPolicy) (response []m
 	}
 	return
 }
+
+// GetRetainedSnapshotsPerLevel returns the number of retained snapshots per level of the plan, as expected by the REST API
+func GetRetainedSnapshotsPerLevel(ctx context.Context, plan models.SnapshotPolicyResourceModel) ([]string, diag.Diagnostics) {
+	var levels []int64
+	diags := plan.NumOfRetainedSnapshotsPerLevel.ElementsAs(ctx, &levels, true)
+	retained := make([]string, 0, len(levels))
+	for _, level := range levels {
+		retained = append(retained, strconv.FormatInt(level, 10))
+	}
+	return retained, diags
+}
+
+// UpdateSnapshotPolicyResourceState saves the state of the snapshot policy and of its source volumes
+func UpdateSnapshotPolicyResourceState(sp *scaleiotypes.SnapshotPolicy, volumes []*scaleiotypes.Volume, state *models.SnapshotPolicyResourceModel) (diags diag.Diagnostics) {
+	state.ID = types.StringValue(sp.ID)
+	state.Name = types.StringValue(sp.Name)
+	state.AutoSnapshotCreationCadenceInMin = types.Int64Value(int64(sp.AutoSnapshotCreationCadenceInMin))
+	state.Paused = types.BoolValue(sp.SnapshotPolicyState == "Paused")
+	state.SecureSnapshots = types.BoolValue(sp.SecureSnapshots)
+	state.SnapshotAccessMode = types.StringValue(sp.SnapshotAccessMode)
+	state.SystemID = types.StringValue(sp.SystemID)
+	if state.RemoveMode.IsNull() || state.RemoveMode.IsUnknown() {
+		state.RemoveMode = types.StringValue("Detach")
+	}
+
+	levels := []attr.Value{}
+	for _, level := range sp.NumOfRetainedSnapshotsPerLevel {
+		levels = append(levels, types.Int64Value(int64(level)))
+	}
+	listVal, dgs := types.ListValue(types.Int64Type, levels)
+	diags = append(diags, dgs...)
+	state.NumOfRetainedSnapshotsPerLevel = listVal
+
+	// the source volumes are not managed when none is attached and the attribute is not set
+	if len(volumes) == 0 && state.VolumeIDs.IsNull() {
+		return diags
+	}
+	volumeIDs := []attr.Value{}
+	for _, vol := range volumes {
+		volumeIDs = append(volumeIDs, types.StringValue(vol.ID))
+	}
+	setVal, dgs := types.SetValue(types.StringType, volumeIDs)
+	diags = append(diags, dgs...)
+	state.VolumeIDs = setVal
+	return diags
+}
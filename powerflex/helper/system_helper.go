@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"sort"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SdcApprovedIpsAttrTypes are the attribute types of the approved IPs of an SDC
+var SdcApprovedIpsAttrTypes = map[string]attr.Type{
+	"sdc_id": types.StringType,
+	"ips":    types.SetType{ElemType: types.StringType},
+}
+
+// GetPlannedSdcApprovedIps returns the approved IPs of the plan or of the state by SDC ID
+func GetPlannedSdcApprovedIps(ctx context.Context, set types.Set) (map[string][]string, diag.Diagnostics) {
+	var sdcs []models.SdcApprovedIpsModel
+	diags := set.ElementsAs(ctx, &sdcs, true)
+	approved := map[string][]string{}
+	for _, sdc := range sdcs {
+		if _, ok := approved[sdc.SdcID.ValueString()]; ok {
+			diags.AddError(
+				"Invalid approved SDC IPs",
+				"the SDC "+sdc.SdcID.ValueString()+" is listed more than once in sdc_approved_ips",
+			)
+			continue
+		}
+		ips := []string{}
+		for _, ip := range sdc.IPs {
+			ips = append(ips, ip.ValueString())
+		}
+		approved[sdc.SdcID.ValueString()] = ips
+	}
+	return approved, diags
+}
+
+// SameIPs reports whether two lists hold the same IPs, whatever their order
+func SameIPs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]string{}, a...), append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateSystemState saves the settings of the system and the approved IPs of the managed SDCs in the resource state.
+// The SDCs approved outside of the resource, like with powerflex_sdc_approval, are not saved.
+func UpdateSystemState(ctx context.Context, settings *client.SystemSettings, sdcs []*client.SdcApprovedIps, managed map[string][]string, state *models.SystemResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	state.ID = types.StringValue(settings.ID)
+	state.Name = types.StringValue(settings.Name)
+	state.RestrictedSdcMode = types.StringValue(settings.RestrictedSdcMode)
+	state.CapacityAlertHighThreshold = types.Int64Value(int64(settings.CapacityAlertHighThresholdPercent))
+	state.CapacityAlertCriticalThreshold = types.Int64Value(int64(settings.CapacityAlertCriticalThresholdPercent))
+	state.CliPasswordAllowed = types.BoolValue(settings.CliPasswordAllowed)
+
+	objects := []attr.Value{}
+	for _, sdc := range sdcs {
+		if _, ok := managed[sdc.ID]; !ok || len(sdc.SdcApprovedIps) == 0 {
+			continue
+		}
+		ips, dgs := types.SetValueFrom(ctx, types.StringType, sdc.SdcApprovedIps)
+		diags = append(diags, dgs...)
+		obj, dgs := types.ObjectValue(SdcApprovedIpsAttrTypes, map[string]attr.Value{
+			"sdc_id": types.StringValue(sdc.ID),
+			"ips":    ips,
+		})
+		diags = append(diags, dgs...)
+		objects = append(objects, obj)
+	}
+	var dgs diag.Diagnostics
+	state.SdcApprovedIps, dgs = types.SetValue(types.ObjectType{AttrTypes: SdcApprovedIpsAttrTypes}, objects)
+	diags = append(diags, dgs...)
+	return diags
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultTimeout is used for create, update and delete operations when no timeout is configured.
+const DefaultTimeout = 20 * time.Minute
+
+// RemovalPollInterval is the interval at which the removal of a device or SDS is polled.
+const RemovalPollInterval = 10 * time.Second
+
+// GetCreateTimeout returns the create timeout configured in the timeouts block.
+func GetCreateTimeout(timeouts *models.TimeoutsModel) (time.Duration, diag.Diagnostics) {
+	if timeouts == nil {
+		return DefaultTimeout, nil
+	}
+	return parseTimeout(timeouts.Create)
+}
+
+// GetUpdateTimeout returns the update timeout configured in the timeouts block.
+func GetUpdateTimeout(timeouts *models.TimeoutsModel) (time.Duration, diag.Diagnostics) {
+	if timeouts == nil {
+		return DefaultTimeout, nil
+	}
+	return parseTimeout(timeouts.Update)
+}
+
+// GetDeleteTimeout returns the delete timeout configured in the timeouts block.
+func GetDeleteTimeout(timeouts *models.TimeoutsModel) (time.Duration, diag.Diagnostics) {
+	if timeouts == nil {
+		return DefaultTimeout, nil
+	}
+	return parseTimeout(timeouts.Delete)
+}
+
+// parseTimeout parses a duration string, falling back to the default timeout when it is not set.
+func parseTimeout(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return DefaultTimeout, diags
+	}
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Invalid Timeout",
+			fmt.Sprintf("Could not parse %s as a duration: %s", value.ValueString(), err.Error()),
+		)
+	}
+	return timeout, diags
+}
+
+// WaitFor calls check every interval until it reports completion, returns an error,
+// or the context deadline is exceeded.
+func WaitFor(ctx context.Context, interval time.Duration, check func() (bool, error)) error {
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the operation to complete: %s", ctx.Err().Error())
+		case <-time.After(interval):
+		}
+	}
+}
+
+// RunWithContext runs call, a goscaleio operation which does not take a context, and returns its error,
+// or an error as soon as the deadline of ctx is exceeded. The operation is not cancelled on the system then.
+func RunWithContext(ctx context.Context, call func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- call()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("timed out waiting for the operation to complete: %s", ctx.Err().Error())
+	}
+}
+
+// DurationValidator validates that a string attribute is a valid duration, like "30s" or "1h30m".
+type DurationValidator struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v DurationValidator) Description(ctx context.Context) string {
+	return "value must be a valid duration string, like \"30s\" or \"2h45m\""
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a valid duration string, like `30s` or `2h45m`"
+}
+
+// ValidateString runs the logic of the validator.
+func (v DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	timeout, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Could not parse %s as a duration: %s", req.ConfigValue.ValueString(), err.Error()),
+		)
+		return
+	}
+	if timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Duration must be greater than zero, got %s", req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"terraform-provider-powerflex/powerflex/models"
+
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpdateUserState saves the user in the resource state, the password is kept as planned
+// since PowerFlex does not return it
+func UpdateUserState(user *scaleiotypes.User, state *models.UserResourceModel) {
+	state.ID = types.StringValue(user.ID)
+	state.Name = types.StringValue(user.Name)
+	state.Role = types.StringValue(user.UserRole)
+	state.ForcePasswordChange = types.BoolValue(user.PasswordChangeRequire)
+	state.SystemID = types.StringValue(user.SystemID)
+}
+
+// GetUserState returns the data source state of the user
+func GetUserState(user *scaleiotypes.User) models.UserModel {
+	return models.UserModel{
+		ID:                     types.StringValue(user.ID),
+		Name:                   types.StringValue(user.Name),
+		Role:                   types.StringValue(user.UserRole),
+		SystemID:               types.StringValue(user.SystemID),
+		PasswordChangeRequired: types.BoolValue(user.PasswordChangeRequire),
+	}
+}
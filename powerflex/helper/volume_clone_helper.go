@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"terraform-provider-powerflex/powerflex/models"
+
+	pftypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpdateVolumeCloneState saves the volume clone in the resource state.
+// The size is given in the capacity unit of the state, the source of the clone is set when it is not known yet.
+func UpdateVolumeCloneState(clone *pftypes.Volume, source *pftypes.Volume, state *models.VolumeCloneResourceModel) {
+	state.ID = types.StringValue(clone.ID)
+	state.Name = types.StringValue(clone.Name)
+	state.AccessMode = types.StringValue(clone.AccessModeLimit)
+	state.StoragePoolID = types.StringValue(clone.StoragePoolID)
+	state.VTreeID = types.StringValue(clone.VTreeID)
+	state.SizeInKb = types.Int64Value(int64(clone.SizeInKb))
+	if state.CapacityUnit.ValueString() == "TB" {
+		state.Size = types.Int64Value(int64(clone.SizeInKb / TiKB))
+	} else {
+		state.CapacityUnit = types.StringValue("GB")
+		state.Size = types.Int64Value(int64(clone.SizeInKb / GiKB))
+	}
+
+	// the source is not known when the clone is imported
+	if source != nil && state.SourceVolumeID.IsNull() && state.SourceSnapshotID.IsNull() {
+		if source.VolumeType == "Snapshot" {
+			state.SourceSnapshotID = types.StringValue(source.ID)
+		} else {
+			state.SourceVolumeID = types.StringValue(source.ID)
+		}
+	}
+}
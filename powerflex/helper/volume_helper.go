@@ -18,6 +18,12 @@ limitations under the License.
 package helper
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"terraform-provider-powerflex/client"
 	"terraform-provider-powerflex/powerflex/models"
 
 	"github.com/dell/goscaleio"
@@ -36,6 +42,12 @@ const (
 	TiKB = 1024 * GiKB
 )
 
+// VolumeGranularityInKb is the granularity in which PowerFlex allocates the capacity of volumes.
+const VolumeGranularityInKb = 8 * GiKB
+
+// VTreeMigrationPollInterval is the interval at which the migration of a VTree is polled.
+const VTreeMigrationPollInterval = 10 * time.Second
+
 // ConvertToKB fucntion to convert size into kb
 func ConvertToKB(capacityUnit string, size int64) int64 {
 	var valInKiB int64
@@ -63,11 +75,117 @@ func RefreshVolumeState(vol *pftypes.Volume, state *models.VolumeResourceModel)
 	return diags
 }
 
+// ValidateVolumeSize checks that the planned size of a volume is a multiple of the allocation
+// granularity and, when the volume already exists, that it does not shrink the volume.
+// The current size is 0 for a volume not created yet.
+func ValidateVolumeSize(sizeInKb, currentSizeInKb int64) (diags diag.Diagnostics) {
+	if sizeInKb <= 0 || sizeInKb%VolumeGranularityInKb != 0 {
+		diags.AddError(
+			"Error: Size Must be in granularity of 8GB",
+			fmt.Sprintf("Could not assign volume with size. sizeInGb (%s) must be a positive number in granularity of 8 GB.", formatSizeInGb(sizeInKb)),
+		)
+		return
+	}
+	if sizeInKb < currentSizeInKb {
+		diags.AddError(
+			"Error: Size cannot be decreased",
+			fmt.Sprintf("Could not resize volume from %s GB to %s GB. The size of a volume can only be increased.", formatSizeInGb(currentSizeInKb), formatSizeInGb(sizeInKb)),
+		)
+	}
+	return
+}
+
+// formatSizeInGb formats a size in KB as gigabytes.
+func formatSizeInGb(sizeInKb int64) string {
+	if sizeInKb%GiKB == 0 {
+		return strconv.FormatInt(sizeInKb/GiKB, 10)
+	}
+	return strconv.FormatFloat(float64(sizeInKb)/GiKB, 'f', 2, 64)
+}
+
+// CheckStoragePoolCapacity warns when the storage pool has less capacity available for volume allocation
+// than the capacity a plan is about to add to its volumes. The plan is not rejected, since thin provisioned
+// volumes do not allocate their capacity upfront.
+func CheckStoragePoolCapacity(c *goscaleio.Client, systemID, storagePoolID string, growthInKb int64) (diags diag.Diagnostics) {
+	if growthInKb <= 0 {
+		return
+	}
+	sp, err := GetStoragePoolType(c, systemID, storagePoolID)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to read the capacity of the storage pool",
+			"Could not get storage pool with id: "+storagePoolID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	stats, err := sp.GetStatistics()
+	if err != nil {
+		diags.AddWarning(
+			"Unable to read the capacity of the storage pool",
+			"Could not get the statistics of storage pool "+sp.StoragePool.Name+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	available := int64(stats.CapacityAvailableForVolumeAllocationInKb)
+	if available < growthInKb {
+		diags.AddWarning(
+			"Storage pool capacity is insufficient",
+			fmt.Sprintf("Storage pool %s has %s GB available for volume allocation, the plan allocates %s GB more. The volume may fail to be resized or to be written.",
+				sp.StoragePool.Name, formatSizeInGb(available), formatSizeInGb(growthInKb)),
+		)
+	}
+	return
+}
+
+// CheckVolumeResize validates the planned size of a volume against its current size, and warns when the storage pool
+// of the volume lacks the capacity to grow it. The capacity is not checked when the volume could not be read.
+func CheckVolumeResize(c *goscaleio.Client, systemID string, volume *pftypes.Volume, sizeInKb, currentSizeInKb int64) diag.Diagnostics {
+	diags := ValidateVolumeSize(sizeInKb, currentSizeInKb)
+	if diags.HasError() || volume == nil {
+		return diags
+	}
+	return CheckStoragePoolCapacity(c, systemID, volume.StoragePoolID, sizeInKb-currentSizeInKb)
+}
+
+// VTreeConversion returns the conversion of the provisioning type of a VTree migration
+// changing the volume type, or an empty string when the volume type does not change.
+func VTreeConversion(from, to string) string {
+	switch {
+	case from == "ThickProvisioned" && to == "ThinProvisioned":
+		return client.VTreeConversionThickToThin
+	case from == "ThinProvisioned" && to == "ThickProvisioned":
+		return client.VTreeConversionThinToThick
+	}
+	return ""
+}
+
+// WaitForVTreeMigration polls a VTree until its migration to the destination storage pool completes.
+// A paused migration is reported as an error, as it only resumes through an action of the administrator.
+func WaitForVTreeMigration(ctx context.Context, c *goscaleio.Client, vtreeID, destSPID string) error {
+	return WaitFor(ctx, VTreeMigrationPollInterval, func() (bool, error) {
+		vtree, err := client.GetVTree(ctx, c, vtreeID)
+		if err != nil {
+			return false, err
+		}
+		switch vtree.MigrationInfo.MigrationStatus {
+		case client.VTreeNotInMigration:
+			if vtree.StoragePoolID != destSPID {
+				return false, fmt.Errorf("the migration of VTree %s stopped before reaching storage pool %s", vtreeID, destSPID)
+			}
+			return true, nil
+		case client.VTreeMigrationPaused:
+			return false, fmt.Errorf("the migration of VTree %s is paused: %s", vtreeID, vtree.MigrationInfo.MigrationPauseReason)
+		}
+		return false, nil
+	})
+}
+
 // GetStoragePoolInstance function to get storage pool from storage pool id and protection domain id
-func GetStoragePoolInstance(c *goscaleio.Client, spID string, pdID string) (*goscaleio.StoragePool, error) {
-	getSystems, _ := c.GetSystems()
-	sr := goscaleio.NewSystem(c)
-	sr.System = getSystems[0]
+func GetStoragePoolInstance(c *goscaleio.Client, systemID string, spID string, pdID string) (*goscaleio.StoragePool, error) {
+	sr, err := GetSystem(c, systemID, "")
+	if err != nil {
+		return nil, err
+	}
 	pdr := goscaleio.NewProtectionDomain(c)
 	protectionDomain, err := sr.FindProtectionDomain(pdID, "", "")
 	if err != nil {
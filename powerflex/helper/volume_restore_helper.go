@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+
+	"terraform-provider-powerflex/powerflex/models"
+
+	pftypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ValidateVolumeRestore checks that a snapshot can overwrite the content of a target volume:
+// the snapshot must be another member of the VTree of the target volume.
+func ValidateVolumeRestore(target, snapshot *pftypes.Volume) error {
+	if snapshot.ID == target.ID {
+		return fmt.Errorf("volume %s cannot be restored from itself, select a snapshot of its VTree %s", target.ID, target.VTreeID)
+	}
+	if snapshot.VTreeID != target.VTreeID {
+		return fmt.Errorf("snapshot %s of volume %s belongs to VTree %s while volume %s belongs to VTree %s,"+
+			" a volume can only be restored from a snapshot of its own VTree",
+			snapshot.ID, snapshot.AncestorVolumeID, snapshot.VTreeID, target.ID, target.VTreeID)
+	}
+	return nil
+}
+
+// UpdateVolumeRestoreState saves the restored volume in the resource state
+func UpdateVolumeRestoreState(target *pftypes.Volume, state *models.VolumeRestoreResourceModel) {
+	state.ID = types.StringValue(target.ID)
+	state.TargetVolumeID = types.StringValue(target.ID)
+	state.VTreeID = types.StringValue(target.VTreeID)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"terraform-provider-powerflex/powerflex/models"
+
+	pftypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// GetVolumeSdcType returns the type of an SDC mapped by the volume SDC mapping resource
+func GetVolumeSdcType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"sdc_id":           types.StringType,
+		"sdc_name":         types.StringType,
+		"sdc_ip":           types.StringType,
+		"sdc_guid":         types.StringType,
+		"limit_iops":       types.Int64Type,
+		"limit_bw_in_mbps": types.Int64Type,
+		"access_mode":      types.StringType,
+	}
+}
+
+// GetVolumeSdcSetValue returns the set of the SDCs mapped by the volume SDC mapping resource
+func GetVolumeSdcSetValue(sdcs []models.VolumeSdcModel) (basetypes.SetValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	sdcElemType := types.ObjectType{
+		AttrTypes: GetVolumeSdcType(),
+	}
+
+	objectSdcs := []attr.Value{}
+	for _, sdc := range sdcs {
+		obj := map[string]attr.Value{
+			"sdc_id":           sdc.SdcID,
+			"sdc_name":         sdc.SdcName,
+			"sdc_ip":           sdc.SdcIP,
+			"sdc_guid":         sdc.SdcGUID,
+			"limit_iops":       sdc.IOPSLimit,
+			"limit_bw_in_mbps": sdc.BWLimit,
+			"access_mode":      sdc.AccessMode,
+		}
+		objVal, dgs := types.ObjectValue(GetVolumeSdcType(), obj)
+		diags = append(diags, dgs...)
+		objectSdcs = append(objectSdcs, objVal)
+	}
+	setVal, dgs := types.SetValue(sdcElemType, objectSdcs)
+	diags = append(diags, dgs...)
+	return setVal, diags
+}
+
+// ResolveVolumeSdcs looks up the SDCs to map to a volume by their ID, name, IP or GUID.
+// The other identifiers of the SDCs are filled in, as well as the default limits and access mode of the mappings.
+// The SDCs whose identifier is not known yet are left as they are.
+func ResolveVolumeSdcs(sdcs []pftypes.Sdc, mappings []models.VolumeSdcModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	resolved := make(map[string]bool)
+	for i := range mappings {
+		mapping := &mappings[i]
+		var field, value string
+		var match func(sdc pftypes.Sdc) bool
+		// the identifiers which are not configured are unknown until the SDC is found
+		switch {
+		case isKnown(mapping.SdcID):
+			field, value = "ID", mapping.SdcID.ValueString()
+			match = func(sdc pftypes.Sdc) bool { return sdc.ID == value }
+		case isKnown(mapping.SdcName):
+			field, value = "name", mapping.SdcName.ValueString()
+			match = func(sdc pftypes.Sdc) bool { return sdc.Name == value }
+		case isKnown(mapping.SdcIP):
+			field, value = "IP", mapping.SdcIP.ValueString()
+			match = func(sdc pftypes.Sdc) bool { return sdc.SdcIP == value }
+		case isKnown(mapping.SdcGUID):
+			field, value = "GUID", mapping.SdcGUID.ValueString()
+			match = func(sdc pftypes.Sdc) bool { return sdc.SdcGUID == value }
+		default:
+			continue
+		}
+
+		var found *pftypes.Sdc
+		for j := range sdcs {
+			if match(sdcs[j]) {
+				found = &sdcs[j]
+				break
+			}
+		}
+		if found == nil {
+			diags.AddError(
+				"Error getting SDC with "+field,
+				"couldn't find SDC with "+field+" "+value,
+			)
+			continue
+		}
+		if resolved[found.ID] {
+			diags.AddError(
+				"Duplicate SDC",
+				"SDC "+found.ID+" is listed more than once in sdc_list",
+			)
+			continue
+		}
+		resolved[found.ID] = true
+
+		mapping.SdcID = types.StringValue(found.ID)
+		mapping.SdcName = types.StringValue(found.Name)
+		mapping.SdcIP = types.StringValue(found.SdcIP)
+		mapping.SdcGUID = types.StringValue(found.SdcGUID)
+		if mapping.IOPSLimit.IsUnknown() {
+			mapping.IOPSLimit = types.Int64Value(0)
+		}
+		if mapping.BWLimit.IsUnknown() {
+			mapping.BWLimit = types.Int64Value(0)
+		}
+		if mapping.AccessMode.IsUnknown() {
+			mapping.AccessMode = types.StringValue("ReadOnly")
+		}
+	}
+	return diags
+}
+
+// isKnown tells whether a string attribute has a value
+func isKnown(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// UpdateVolumeSdcMappingState saves the SDCs mapped to the volume in the resource state
+func UpdateVolumeSdcMappingState(volume *pftypes.Volume, sdcs []pftypes.Sdc, state *models.VolumeSdcMappingResourceModel) diag.Diagnostics {
+	state.ID = types.StringValue(volume.ID)
+	state.VolumeID = types.StringValue(volume.ID)
+	state.VolumeName = types.StringValue(volume.Name)
+
+	mappings := []models.VolumeSdcModel{}
+	for _, info := range volume.MappedSdcInfo {
+		mapping := models.VolumeSdcModel{
+			SdcID:      types.StringValue(info.SdcID),
+			SdcName:    types.StringValue(info.SdcName),
+			SdcIP:      types.StringValue(info.SdcIP),
+			SdcGUID:    types.StringValue(""),
+			IOPSLimit:  types.Int64Value(int64(info.LimitIops)),
+			BWLimit:    types.Int64Value(int64(info.LimitBwInMbps)),
+			AccessMode: types.StringValue(info.AccessMode),
+		}
+		// the GUID of the SDC is not part of the mapping information of the volume
+		for _, sdc := range sdcs {
+			if sdc.ID == info.SdcID {
+				mapping.SdcName = types.StringValue(sdc.Name)
+				mapping.SdcIP = types.StringValue(sdc.SdcIP)
+				mapping.SdcGUID = types.StringValue(sdc.SdcGUID)
+				break
+			}
+		}
+		mappings = append(mappings, mapping)
+	}
+	setVal, diags := GetVolumeSdcSetValue(mappings)
+	state.SdcList = setVal
+	return diags
+}
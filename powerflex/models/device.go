@@ -23,21 +23,24 @@ import (
 
 // DeviceModel defines the struct for device resource
 type DeviceModel struct {
-	ID                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	DevicePath               types.String `tfsdk:"device_path"`
-	DeviceOriginalPath       types.String `tfsdk:"device_original_path"`
-	ProtectionDomainName     types.String `tfsdk:"protection_domain_name"`
-	ProtectionDomainID       types.String `tfsdk:"protection_domain_id"`
-	StoragePoolName          types.String `tfsdk:"storage_pool_name"`
-	StoragePoolID            types.String `tfsdk:"storage_pool_id"`
-	SdsID                    types.String `tfsdk:"sds_id"`
-	SdsName                  types.String `tfsdk:"sds_name"`
-	MediaType                types.String `tfsdk:"media_type"`
-	ExternalAccelerationType types.String `tfsdk:"external_acceleration_type"`
-	DeviceCapacity           types.Int64  `tfsdk:"device_capacity"`
-	DeviceCapacityInKB       types.Int64  `tfsdk:"device_capacity_in_kb"`
-	DeviceState              types.String `tfsdk:"device_state"`
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	DevicePath               types.String   `tfsdk:"device_path"`
+	DeviceOriginalPath       types.String   `tfsdk:"device_original_path"`
+	ProtectionDomainName     types.String   `tfsdk:"protection_domain_name"`
+	ProtectionDomainID       types.String   `tfsdk:"protection_domain_id"`
+	StoragePoolName          types.String   `tfsdk:"storage_pool_name"`
+	StoragePoolID            types.String   `tfsdk:"storage_pool_id"`
+	AccelerationPoolID       types.String   `tfsdk:"acceleration_pool_id"`
+	SdsID                    types.String   `tfsdk:"sds_id"`
+	SdsName                  types.String   `tfsdk:"sds_name"`
+	MediaType                types.String   `tfsdk:"media_type"`
+	ExternalAccelerationType types.String   `tfsdk:"external_acceleration_type"`
+	DeviceCapacity           types.Int64    `tfsdk:"device_capacity"`
+	DeviceCapacityInKB       types.Int64    `tfsdk:"device_capacity_in_kb"`
+	DeviceState              types.String   `tfsdk:"device_state"`
+	SystemID                 types.String   `tfsdk:"system_id"`
+	Timeouts                 *TimeoutsModel `tfsdk:"timeouts"`
 }
 
 // DeviceDataSourceModel defines struct for device datasource
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FaultSetResourceModel maps the fault set resource schema data.
+type FaultSetResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	ProtectionDomainID   types.String `tfsdk:"protection_domain_id"`
+	ProtectionDomainName types.String `tfsdk:"protection_domain_name"`
+	SystemID             types.String `tfsdk:"system_id"`
+}
+
+// FaultSetDataSourceModel defines struct for fault set data source
+type FaultSetDataSourceModel struct {
+	FaultSets          []FaultSetModel `tfsdk:"fault_sets"`
+	ID                 types.String    `tfsdk:"id"`
+	Name               types.String    `tfsdk:"name"`
+	ProtectionDomainID types.String    `tfsdk:"protection_domain_id"`
+}
+
+// FaultSetModel defines struct for fault set model
+type FaultSetModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	ProtectionDomainID types.String   `tfsdk:"protection_domain_id"`
+	SdsIDs             []types.String `tfsdk:"sds_ids"`
+}
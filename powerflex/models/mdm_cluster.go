@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MdmClusterResourceModel maps the MDM cluster resource schema data.
+type MdmClusterResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ClusterMode   types.String `tfsdk:"cluster_mode"`
+	ClusterState  types.String `tfsdk:"cluster_state"`
+	PrimaryMdm    types.Object `tfsdk:"primary_mdm"`
+	SecondaryMdm  types.Set    `tfsdk:"secondary_mdm"`
+	TieBreakerMdm types.Set    `tfsdk:"tiebreaker_mdm"`
+	StandbyMdm    types.Set    `tfsdk:"standby_mdm"`
+}
+
+// MdmModel maps a member of the MDM cluster.
+type MdmModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Port          types.Int64  `tfsdk:"port"`
+	IPs           types.List   `tfsdk:"ips"`
+	ManagementIPs types.List   `tfsdk:"management_ips"`
+	Role          types.String `tfsdk:"role"`
+	Status        types.String `tfsdk:"status"`
+}
+
+// MdmClusterDataSourceModel defines struct for MDM cluster data source
+type MdmClusterDataSourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	ClusterMode     types.String   `tfsdk:"cluster_mode"`
+	ClusterState    types.String   `tfsdk:"cluster_state"`
+	GoodNodesNum    types.Int64    `tfsdk:"good_nodes_num"`
+	GoodReplicasNum types.Int64    `tfsdk:"good_replicas_num"`
+	PrimaryMdm      *MdmDataModel  `tfsdk:"primary_mdm"`
+	SecondaryMdm    []MdmDataModel `tfsdk:"secondary_mdm"`
+	TieBreakerMdm   []MdmDataModel `tfsdk:"tiebreaker_mdm"`
+	StandbyMdm      []MdmDataModel `tfsdk:"standby_mdm"`
+}
+
+// MdmDataModel defines struct for a member of the MDM cluster in the data source
+type MdmDataModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Port          types.Int64    `tfsdk:"port"`
+	IPs           []types.String `tfsdk:"ips"`
+	ManagementIPs []types.String `tfsdk:"management_ips"`
+	Role          types.String   `tfsdk:"role"`
+	Status        types.String   `tfsdk:"status"`
+	VersionInfo   types.String   `tfsdk:"version_info"`
+}
@@ -45,11 +45,12 @@ type ProtectionDomainResourceModel struct {
 	RfCachePageSizeKb      types.Int64  `tfsdk:"rf_cache_page_size_kb"`
 	RfCacheMaxIoSizeKb     types.Int64  `tfsdk:"rf_cache_max_io_size_kb"`
 
-	Active types.Bool   `tfsdk:"active"`
-	State  types.String `tfsdk:"state"`
-	Name   types.String `tfsdk:"name"`
-	ID     types.String `tfsdk:"id"`
-	Links  types.List   `tfsdk:"links"`
+	Active   types.Bool   `tfsdk:"active"`
+	State    types.String `tfsdk:"state"`
+	Name     types.String `tfsdk:"name"`
+	ID       types.String `tfsdk:"id"`
+	SystemID types.String `tfsdk:"system_id"`
+	Links    types.List   `tfsdk:"links"`
 }
 
 // ProtectionDomainDataSourceModel defines struct for protection domain data source
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PeerSystemResourceModel maps the peer system resource schema data.
+type PeerSystemResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	RemoteSystemID      types.String `tfsdk:"remote_system_id"`
+	IPList              types.List   `tfsdk:"ip_list"`
+	Port                types.Int64  `tfsdk:"port"`
+	PerformanceProfile  types.String `tfsdk:"performance_profile"`
+	SystemID            types.String `tfsdk:"system_id"`
+	SoftwareVersionInfo types.String `tfsdk:"software_version_info"`
+	MembershipState     types.String `tfsdk:"membership_state"`
+	CouplingRC          types.String `tfsdk:"coupling_rc"`
+}
+
+// ReplicationConsistencyGroupResourceModel maps the replication consistency group resource schema data.
+type ReplicationConsistencyGroupResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	PeerSystemID             types.String `tfsdk:"peer_system_id"`
+	ProtectionDomainID       types.String `tfsdk:"protection_domain_id"`
+	RemoteProtectionDomainID types.String `tfsdk:"remote_protection_domain_id"`
+	RpoInSeconds             types.Int64  `tfsdk:"rpo_in_seconds"`
+	TargetVolumeAccessMode   types.String `tfsdk:"target_volume_access_mode"`
+	Active                   types.Bool   `tfsdk:"active"`
+	Paused                   types.Bool   `tfsdk:"paused"`
+	DestinationSystemID      types.String `tfsdk:"destination_system_id"`
+	RemoteID                 types.String `tfsdk:"remote_id"`
+	ReplicationDirection     types.String `tfsdk:"replication_direction"`
+	CurrConsistMode          types.String `tfsdk:"curr_consist_mode"`
+}
+
+// ReplicationPairResourceModel maps the replication pair resource schema data.
+type ReplicationPairResourceModel struct {
+	ID                            types.String  `tfsdk:"id"`
+	Name                          types.String  `tfsdk:"name"`
+	ReplicationConsistencyGroupID types.String  `tfsdk:"replication_consistency_group_id"`
+	SourceVolumeID                types.String  `tfsdk:"source_volume_id"`
+	SourceVolumeName              types.String  `tfsdk:"source_volume_name"`
+	TargetVolumeID                types.String  `tfsdk:"target_volume_id"`
+	TargetVolumeName              types.String  `tfsdk:"target_volume_name"`
+	CopyType                      types.String  `tfsdk:"copy_type"`
+	RemoteID                      types.String  `tfsdk:"remote_id"`
+	PeerSystemName                types.String  `tfsdk:"peer_system_name"`
+	LifetimeState                 types.String  `tfsdk:"lifetime_state"`
+	InitialCopyState              types.String  `tfsdk:"initial_copy_state"`
+	InitialCopyProgress           types.Float64 `tfsdk:"initial_copy_progress"`
+}
+
+// ReplicationConsistencyGroupActionResourceModel maps the replication consistency group action resource schema data.
+type ReplicationConsistencyGroupActionResourceModel struct {
+	ID                            types.String   `tfsdk:"id"`
+	ReplicationConsistencyGroupID types.String   `tfsdk:"replication_consistency_group_id"`
+	Action                        types.String   `tfsdk:"action"`
+	PollInterval                  types.String   `tfsdk:"poll_interval"`
+	FailoverType                  types.String   `tfsdk:"failover_type"`
+	FailoverState                 types.String   `tfsdk:"failover_state"`
+	FreezeState                   types.String   `tfsdk:"freeze_state"`
+	PauseMode                     types.String   `tfsdk:"pause_mode"`
+	ReplicationDirection          types.String   `tfsdk:"replication_direction"`
+	DisasterRecoveryState         types.String   `tfsdk:"disaster_recovery_state"`
+	Timeouts                      *TimeoutsModel `tfsdk:"timeouts"`
+}
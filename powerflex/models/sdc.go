@@ -76,11 +76,12 @@ var SdcResourceSchemaDescriptions = struct {
 
 // SdcResourceModel struct for CSV Data Processing
 type SdcResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	SDCDetails  types.List   `tfsdk:"sdc_details"`
-	MdmPassword types.String `tfsdk:"mdm_password"`
-	LiaPassword types.String `tfsdk:"lia_password"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	SDCDetails  types.List     `tfsdk:"sdc_details"`
+	MdmPassword types.String   `tfsdk:"mdm_password"`
+	LiaPassword types.String   `tfsdk:"lia_password"`
+	Timeouts    *TimeoutsModel `tfsdk:"timeouts"`
 }
 
 // SDCDetailDataModel defines the struct for CSV Parse Data
@@ -26,25 +26,28 @@ import (
 
 // SdsResourceModel maps the resource schema data.
 type SdsResourceModel struct {
-	ID                           types.String `tfsdk:"id"`
-	Name                         types.String `tfsdk:"name"`
-	ProtectionDomainID           types.String `tfsdk:"protection_domain_id"`
-	ProtectionDomainName         types.String `tfsdk:"protection_domain_name"`
-	IPList                       types.Set    `tfsdk:"ip_list"`
-	Port                         types.Int64  `tfsdk:"port"`
-	SdsState                     types.String `tfsdk:"sds_state"`
-	MembershipState              types.String `tfsdk:"membership_state"`
-	MdmConnectionState           types.String `tfsdk:"mdm_connection_state"`
-	DrlMode                      types.String `tfsdk:"drl_mode"`
-	RmcacheEnabled               types.Bool   `tfsdk:"rmcache_enabled"`
-	RmcacheSizeInMB              types.Int64  `tfsdk:"rmcache_size_in_mb"`
-	RfcacheEnabled               types.Bool   `tfsdk:"rfcache_enabled"`
-	RmcacheFrozen                types.Bool   `tfsdk:"rmcache_frozen"`
-	IsOnVMware                   types.Bool   `tfsdk:"is_on_vmware"`
-	FaultSetID                   types.String `tfsdk:"fault_set_id"`
-	NumOfIoBuffers               types.Int64  `tfsdk:"num_of_io_buffers"`
-	RmcacheMemoryAllocationState types.String `tfsdk:"rmcache_memory_allocation_state"`
-	PerformanceProfile           types.String `tfsdk:"performance_profile"`
+	ID                           types.String   `tfsdk:"id"`
+	Name                         types.String   `tfsdk:"name"`
+	ProtectionDomainID           types.String   `tfsdk:"protection_domain_id"`
+	ProtectionDomainName         types.String   `tfsdk:"protection_domain_name"`
+	IPList                       types.Set      `tfsdk:"ip_list"`
+	Port                         types.Int64    `tfsdk:"port"`
+	SdsState                     types.String   `tfsdk:"sds_state"`
+	MembershipState              types.String   `tfsdk:"membership_state"`
+	MdmConnectionState           types.String   `tfsdk:"mdm_connection_state"`
+	DrlMode                      types.String   `tfsdk:"drl_mode"`
+	RmcacheEnabled               types.Bool     `tfsdk:"rmcache_enabled"`
+	RmcacheSizeInMB              types.Int64    `tfsdk:"rmcache_size_in_mb"`
+	RfcacheEnabled               types.Bool     `tfsdk:"rfcache_enabled"`
+	RmcacheFrozen                types.Bool     `tfsdk:"rmcache_frozen"`
+	IsOnVMware                   types.Bool     `tfsdk:"is_on_vmware"`
+	FaultSetID                   types.String   `tfsdk:"fault_set_id"`
+	FaultSetName                 types.String   `tfsdk:"fault_set_name"`
+	NumOfIoBuffers               types.Int64    `tfsdk:"num_of_io_buffers"`
+	RmcacheMemoryAllocationState types.String   `tfsdk:"rmcache_memory_allocation_state"`
+	PerformanceProfile           types.String   `tfsdk:"performance_profile"`
+	SystemID                     types.String   `tfsdk:"system_id"`
+	Timeouts                     *TimeoutsModel `tfsdk:"timeouts"`
 }
 
 // SdsIPModel IP object
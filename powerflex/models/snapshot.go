@@ -46,3 +46,21 @@ type SdcList struct {
 	SdcName       types.String `tfsdk:"sdc_name"`
 	AccessMode    types.String `tfsdk:"access_mode"`
 }
+
+// SnapshotGroupResourceModel maps the snapshot group resource schema data.
+type SnapshotGroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	VolumeIDs   types.Set    `tfsdk:"volume_ids"`
+	VolumeNames types.Set    `tfsdk:"volume_names"`
+	NamePrefix  types.String `tfsdk:"name_prefix"`
+	AccessMode  types.String `tfsdk:"access_mode"`
+	Snapshots   types.List   `tfsdk:"snapshots"`
+}
+
+// SnapshotGroupMember maps a snapshot of the snapshot group to its volume.
+type SnapshotGroupMember struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	VolumeID   types.String `tfsdk:"volume_id"`
+	VolumeName types.String `tfsdk:"volume_name"`
+}
@@ -57,3 +57,17 @@ type SnapshotPolicyLinkModel struct {
 	Rel  types.String `tfsdk:"rel"`
 	HREF types.String `tfsdk:"href"`
 }
+
+// SnapshotPolicyResourceModel maps the snapshot policy resource schema data.
+type SnapshotPolicyResourceModel struct {
+	ID                               types.String `tfsdk:"id"`
+	Name                             types.String `tfsdk:"name"`
+	AutoSnapshotCreationCadenceInMin types.Int64  `tfsdk:"auto_snapshot_creation_cadence_in_min"`
+	NumOfRetainedSnapshotsPerLevel   types.List   `tfsdk:"num_of_retained_snapshots_per_level"`
+	Paused                           types.Bool   `tfsdk:"paused"`
+	SecureSnapshots                  types.Bool   `tfsdk:"secure_snapshots"`
+	SnapshotAccessMode               types.String `tfsdk:"snapshot_access_mode"`
+	VolumeIDs                        types.Set    `tfsdk:"volume_ids"`
+	RemoveMode                       types.String `tfsdk:"remove_mode"`
+	SystemID                         types.String `tfsdk:"system_id"`
+}
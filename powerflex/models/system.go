@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SystemResourceModel maps the system resource schema data.
+type SystemResourceModel struct {
+	ID                             types.String `tfsdk:"id"`
+	Name                           types.String `tfsdk:"name"`
+	RestrictedSdcMode              types.String `tfsdk:"restricted_sdc_mode"`
+	SdcApprovedIps                 types.Set    `tfsdk:"sdc_approved_ips"`
+	CapacityAlertHighThreshold     types.Int64  `tfsdk:"capacity_alert_high_threshold"`
+	CapacityAlertCriticalThreshold types.Int64  `tfsdk:"capacity_alert_critical_threshold"`
+	CliPasswordAllowed             types.Bool   `tfsdk:"cli_password_allowed"`
+}
+
+// SdcApprovedIpsModel defines the IPs an SDC is approved to connect from.
+type SdcApprovedIpsModel struct {
+	SdcID types.String   `tfsdk:"sdc_id"`
+	IPs   []types.String `tfsdk:"ips"`
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UserResourceModel maps the user resource schema data.
+type UserResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Role                types.String `tfsdk:"role"`
+	Password            types.String `tfsdk:"password"`
+	ForcePasswordChange types.Bool   `tfsdk:"force_password_change"`
+	SystemID            types.String `tfsdk:"system_id"`
+}
+
+// UserDataSourceModel defines struct for user data source
+type UserDataSourceModel struct {
+	Users []UserModel  `tfsdk:"users"`
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+}
+
+// UserModel defines struct for user model
+type UserModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Role                   types.String `tfsdk:"role"`
+	SystemID               types.String `tfsdk:"system_id"`
+	PasswordChangeRequired types.Bool   `tfsdk:"password_change_required"`
+}
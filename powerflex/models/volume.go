@@ -23,20 +23,22 @@ import (
 
 // VolumeResourceModel maps the resource schema data.
 type VolumeResourceModel struct {
-	ProtectionDomainName types.String `tfsdk:"protection_domain_name"`
-	ProtectionDomainID   types.String `tfsdk:"protection_domain_id"`
-	StoragePoolName      types.String `tfsdk:"storage_pool_name"`
-	StoragePoolID        types.String `tfsdk:"storage_pool_id"`
-	VolumeType           types.String `tfsdk:"volume_type"`
-	UseRmCache           types.Bool   `tfsdk:"use_rm_cache"`
-	CompressionMethod    types.String `tfsdk:"compression_method"`
-	Size                 types.Int64  `tfsdk:"size"`
-	CapacityUnit         types.String `tfsdk:"capacity_unit"`
-	Name                 types.String `tfsdk:"name"`
-	SizeInKb             types.Int64  `tfsdk:"size_in_kb"`
-	ID                   types.String `tfsdk:"id"`
-	AccessMode           types.String `tfsdk:"access_mode"`
-	RemoveMode           types.String `tfsdk:"remove_mode"`
+	ProtectionDomainName types.String   `tfsdk:"protection_domain_name"`
+	ProtectionDomainID   types.String   `tfsdk:"protection_domain_id"`
+	StoragePoolName      types.String   `tfsdk:"storage_pool_name"`
+	StoragePoolID        types.String   `tfsdk:"storage_pool_id"`
+	VolumeType           types.String   `tfsdk:"volume_type"`
+	UseRmCache           types.Bool     `tfsdk:"use_rm_cache"`
+	CompressionMethod    types.String   `tfsdk:"compression_method"`
+	Size                 types.Int64    `tfsdk:"size"`
+	CapacityUnit         types.String   `tfsdk:"capacity_unit"`
+	Name                 types.String   `tfsdk:"name"`
+	SizeInKb             types.Int64    `tfsdk:"size_in_kb"`
+	ID                   types.String   `tfsdk:"id"`
+	AccessMode           types.String   `tfsdk:"access_mode"`
+	RemoveMode           types.String   `tfsdk:"remove_mode"`
+	SystemID             types.String   `tfsdk:"system_id"`
+	Timeouts             *TimeoutsModel `tfsdk:"timeouts"`
 }
 
 // SDCItemize maps the sdc_list schema data
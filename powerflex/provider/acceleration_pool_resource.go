@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &accelerationPoolResource{}
+	_ resource.ResourceWithConfigure   = &accelerationPoolResource{}
+	_ resource.ResourceWithImportState = &accelerationPoolResource{}
+)
+
+// NewAccelerationPoolResource is a helper function to simplify the provider implementation.
+func NewAccelerationPoolResource() resource.Resource {
+	return &accelerationPoolResource{}
+}
+
+// accelerationPoolResource is the resource implementation.
+type accelerationPoolResource struct {
+	client   *goscaleio.Client
+	systemID string
+}
+
+// Metadata returns the resource type name.
+func (r *accelerationPoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acceleration_pool"
+}
+
+// Schema defines the schema for the resource.
+func (r *accelerationPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = AccelerationPoolResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *accelerationPoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *accelerationPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.AccelerationPoolResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pdm, err := helper.GetNewProtectionDomainEx(r.client, helper.SystemIDOrDefault(plan.SystemID, r.systemID), plan.ProtectionDomainID.ValueString(), plan.ProtectionDomainName.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting Protection Domain",
+			err.Error(),
+		)
+		return
+	}
+
+	// set the protection domain name and system ID in the plan so that they get propagated to the state
+	plan.ProtectionDomainName = types.StringValue(pdm.ProtectionDomain.Name)
+	plan.SystemID = types.StringValue(pdm.ProtectionDomain.SystemID)
+
+	id, err := client.CreateAccelerationPool(ctx, r.client, &client.AccelerationPoolCreateParam{
+		Name:               plan.Name.ValueString(),
+		ProtectionDomainID: pdm.ProtectionDomain.ID,
+		MediaType:          plan.MediaType.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating acceleration pool",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "[POWERFLEX] acceleration pool "+id+" created")
+
+	pool, err := client.GetAccelerationPool(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting acceleration pool after creation",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	helper.UpdateAccelerationPoolState(pool, &plan)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *accelerationPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.AccelerationPoolResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := client.GetAccelerationPool(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		// remove the acceleration pool from the state when it has been deleted outside of terraform
+		if helper.IsNotFoundError(err) {
+			tflog.Warn(ctx, "[POWERFLEX] acceleration pool "+state.ID.ValueString()+" not found, removing it from the state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error getting acceleration pool",
+			"Could not get acceleration pool, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// the protection domain name and the system ID are not known when the acceleration pool is imported
+	if state.ProtectionDomainName.IsNull() || state.SystemID.IsNull() {
+		system, err := helper.GetSystem(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), "")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in getting system instance on the PowerFlex cluster",
+				err.Error(),
+			)
+			return
+		}
+		state.SystemID = types.StringValue(system.System.ID)
+		protectionDomain, err := system.FindProtectionDomain(pool.ProtectionDomainID, "", "")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read name of protection domain of ID "+pool.ProtectionDomainID+" for acceleration pool "+pool.Name,
+				err.Error(),
+			)
+			return
+		}
+		state.ProtectionDomainName = types.StringValue(protectionDomain.Name)
+	}
+
+	helper.UpdateAccelerationPoolState(pool, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Only the name of the acceleration pool can be updated, the other attributes require a replacement.
+func (r *accelerationPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.AccelerationPoolResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var state models.AccelerationPoolResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.ValueString() != state.Name.ValueString() {
+		if err := client.RenameAccelerationPool(ctx, r.client, state.ID.ValueString(), plan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error renaming acceleration pool",
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	pool, err := client.GetAccelerationPool(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting acceleration pool after update",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	helper.UpdateAccelerationPoolState(pool, &plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *accelerationPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.AccelerationPoolResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RemoveAccelerationPool(ctx, r.client, state.ID.ValueString())
+	if err != nil && !helper.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error removing acceleration pool",
+			"Couldn't remove acceleration pool, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the acceleration pool by its ID.
+func (r *accelerationPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
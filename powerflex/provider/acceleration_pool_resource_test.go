@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var createAccelerationPoolPosTest = `
+resource "powerflex_acceleration_pool" "accp" {
+	name = "tfacc-acceleration-pool"
+	protection_domain_name = "domain1"
+	media_type = "NVDIMM"
+}
+`
+
+var updateAccelerationPoolPosTest = `
+resource "powerflex_acceleration_pool" "accp" {
+	name = "tfacc-acceleration-pool-1"
+	protection_domain_name = "domain1"
+	media_type = "NVDIMM"
+}
+`
+
+var addAccelerationDevicePosTest = updateAccelerationPoolPosTest + createSDSForTest + `
+resource "powerflex_device" "acceleration-device" {
+	device_path = "/dev/pmem0"
+	acceleration_pool_id = powerflex_acceleration_pool.accp.id
+	sds_id = powerflex_sds.sds.id
+	media_type = "NVDIMM"
+}
+`
+
+var createAccelerationPoolInvalidMediaTypeTest = `
+resource "powerflex_acceleration_pool" "accp-invalid" {
+	name = "tfacc-acceleration-pool-invalid"
+	protection_domain_name = "domain1"
+	media_type = "HDD"
+}
+`
+
+var createAccelerationPoolConflictTest = `
+resource "powerflex_acceleration_pool" "accp-invalid" {
+	name = "tfacc-acceleration-pool-invalid"
+	protection_domain_name = "domain1"
+	protection_domain_id = "` + protectionDomainID1 + `"
+	media_type = "SSD"
+}
+`
+
+var addAccelerationDeviceConflictTest = updateAccelerationPoolPosTest + `
+resource "powerflex_device" "acceleration-device" {
+	device_path = "/dev/pmem0"
+	acceleration_pool_id = powerflex_acceleration_pool.accp.id
+	storage_pool_name = "pool1"
+	protection_domain_name = "domain1"
+	sds_name = "SDS_1"
+}
+`
+
+func TestAccAccelerationPoolResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createAccelerationPoolConflictTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			{
+				Config:      ProviderConfigForTesting + createAccelerationPoolInvalidMediaTypeTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createAccelerationPoolPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_acceleration_pool.accp", "name", "tfacc-acceleration-pool"),
+					resource.TestCheckResourceAttr("powerflex_acceleration_pool.accp", "protection_domain_name", "domain1"),
+					resource.TestCheckResourceAttr("powerflex_acceleration_pool.accp", "protection_domain_id", protectionDomainID1),
+					resource.TestCheckResourceAttr("powerflex_acceleration_pool.accp", "media_type", "NVDIMM"),
+					resource.TestCheckResourceAttrSet("powerflex_acceleration_pool.accp", "id"),
+					resource.TestCheckResourceAttrSet("powerflex_acceleration_pool.accp", "system_id"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_acceleration_pool.accp",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + updateAccelerationPoolPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_acceleration_pool.accp", "name", "tfacc-acceleration-pool-1"),
+					resource.TestCheckResourceAttr("powerflex_acceleration_pool.accp", "protection_domain_id", protectionDomainID1),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + addAccelerationDeviceConflictTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			// add an acceleration device to the acceleration pool
+			{
+				Config: ProviderConfigForTesting + addAccelerationDevicePosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("powerflex_device.acceleration-device", "acceleration_pool_id", "powerflex_acceleration_pool.accp", "id"),
+					resource.TestCheckResourceAttrPair("powerflex_device.acceleration-device", "sds_id", "powerflex_sds.sds", "id"),
+					resource.TestCheckResourceAttr("powerflex_device.acceleration-device", "media_type", "NVDIMM"),
+					resource.TestCheckNoResourceAttr("powerflex_device.acceleration-device", "storage_pool_id"),
+				),
+			},
+			// check that import is working for the acceleration device
+			{
+				ResourceName: "powerflex_device.acceleration-device",
+				ImportState:  true,
+			},
+		},
+	})
+}
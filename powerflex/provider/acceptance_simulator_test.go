@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
+	"terraform-provider-powerflex/powerflex/simulator"
+
+	"github.com/joho/godotenv"
+)
+
+// simulatorEndpointEnv holds the endpoint of the simulator in the environment of the process
+// which runs the acceptance tests against it.
+const simulatorEndpointEnv = "POWERFLEX_SIMULATOR_ENDPOINT"
+
+// SimulatorEndpoint is the endpoint of the PowerFlex simulator the acceptance tests run against
+// when POWERFLEX_SIMULATOR is true, and empty otherwise.
+var SimulatorEndpoint string
+
+// TestMain starts the simulator when POWERFLEX_SIMULATOR is true, before the tests are run.
+//
+// The test data of the package is read from the environment when the package variables are
+// initialized, before TestMain, so the tests then run in a child process whose environment is
+// overridden with the endpoint, credentials and fixtures of the simulator.
+func TestMain(m *testing.M) {
+	godotenv.Load("POWERFLEX_TERRAFORM_TEST.env")
+	SimulatorEndpoint = os.Getenv(simulatorEndpointEnv)
+	if os.Getenv("POWERFLEX_SIMULATOR") != "true" || SimulatorEndpoint != "" {
+		os.Exit(m.Run())
+	}
+	os.Exit(runWithSimulator())
+}
+
+// runWithSimulator starts the simulator and runs the test binary again against it, it returns the
+// exit code of the tests.
+func runWithSimulator() int {
+	sim := simulator.New()
+	endpoint := sim.Start()
+	defer sim.Close()
+
+	cmd := exec.Command(os.Args[0], os.Args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	cmd.Env = append(os.Environ(), simulatorEndpointEnv+"="+endpoint)
+	for key, value := range sim.Environment(endpoint) {
+		cmd.Env = append(cmd.Env, key+"="+value)
+	}
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "unable to run the tests against the simulator: "+err.Error())
+		return 1
+	}
+	return 0
+}
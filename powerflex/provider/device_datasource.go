@@ -42,8 +42,9 @@ func DeviceDataSource() datasource.DataSource {
 }
 
 type deviceDataSource struct {
-	client *goscaleio.Client
-	system *goscaleio.System
+	client   *goscaleio.Client
+	systemID string
+	system   *goscaleio.System
 }
 
 func (d *deviceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -59,9 +60,11 @@ func (d *deviceDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	d.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	d.client = p.client
+	d.systemID = p.systemID
 
-	system, err := helper.GetFirstSystem(d.client)
+	system, err := helper.GetSystem(d.client, d.systemID, "")
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -127,7 +130,7 @@ func (d *deviceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		var sp *goscaleio.StoragePool
 		var err error
 		if !state.StoragePoolName.IsNull() {
-			pd, err := helper.GetNewProtectionDomainEx(d.client, state.ProtectionDomainID.ValueString(), state.ProtectionDomainName.ValueString(), "")
+			pd, err := helper.GetNewProtectionDomainEx(d.client, d.systemID, state.ProtectionDomainID.ValueString(), state.ProtectionDomainName.ValueString(), "")
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error in getting protection domain details with ID: "+state.ProtectionDomainID.ValueString()+" name: "+state.ProtectionDomainName.ValueString(),
@@ -253,7 +256,7 @@ func (d *deviceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 func getStoragePool(d *deviceDataSource, storagePoolID string) (*goscaleio.StoragePool, error) {
 
-	system, err := helper.GetFirstSystem(d.client)
+	system, err := helper.GetSystem(d.client, d.systemID, "")
 	if err != nil {
 		return nil, err
 	}
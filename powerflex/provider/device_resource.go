@@ -20,6 +20,7 @@ package provider
 import (
 	"context"
 
+	"terraform-provider-powerflex/client"
 	"terraform-provider-powerflex/powerflex/helper"
 	"terraform-provider-powerflex/powerflex/models"
 
@@ -30,8 +31,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // NewDeviceResource is a helper function to simplify the provider implementation.
@@ -41,8 +45,8 @@ func NewDeviceResource() resource.Resource {
 
 // deviceResource is the resource implementation.
 type deviceResource struct {
-	client *goscaleio.Client
-	system *goscaleio.System
+	client   *goscaleio.Client
+	systemID string
 }
 
 func (r *deviceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -77,13 +81,13 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"storage_pool_id": schema.StringAttribute{
-				Description:         "ID of the storage pool. Conflicts with 'storage_pool_name'. Cannot be updated.",
+				Description:         "ID of the storage pool. Conflicts with 'storage_pool_name' and 'acceleration_pool_id'. Cannot be updated.",
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "ID of the storage pool. Conflicts with `storage_pool_name`. Cannot be updated.",
+				MarkdownDescription: "ID of the storage pool. Conflicts with `storage_pool_name` and `acceleration_pool_id`. Cannot be updated.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
-					stringvalidator.ExactlyOneOf(path.MatchRoot("storage_pool_name")),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("storage_pool_name"), path.MatchRoot("acceleration_pool_id")),
 					stringvalidator.ConflictsWith(path.MatchRoot("protection_domain_name")),
 					stringvalidator.ConflictsWith(path.MatchRoot("protection_domain_id")),
 				},
@@ -97,6 +101,18 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"acceleration_pool_id": schema.StringAttribute{
+				Description: "ID of the acceleration pool to which the device is added as an acceleration device, instead of a storage pool." +
+					" Conflicts with 'storage_pool_id' and 'storage_pool_name'. Cannot be updated.",
+				MarkdownDescription: "ID of the acceleration pool to which the device is added as an acceleration device, instead of a storage pool." +
+					" Conflicts with `storage_pool_id` and `storage_pool_name`. Cannot be updated.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("protection_domain_name")),
+					stringvalidator.ConflictsWith(path.MatchRoot("protection_domain_id")),
+				},
+			},
 			"protection_domain_id": schema.StringAttribute{
 				Description:         "ID of the protection domain. Conflicts with 'protection_domain_name'. Cannot be updated.",
 				MarkdownDescription: "ID of the protection domain. Conflicts with `protection_domain_name`. Cannot be updated.",
@@ -134,13 +150,14 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"media_type": schema.StringAttribute{
-				Description:         "Media type of the device. Valid values are 'HDD', 'SSD'.",
-				MarkdownDescription: "Media type of the device. Valid values are `HDD`, `SSD`.",
+				Description:         "Media type of the device. Valid values are 'HDD', 'SSD', 'NVDIMM'. 'NVDIMM' is only valid for acceleration devices.",
+				MarkdownDescription: "Media type of the device. Valid values are `HDD`, `SSD`, `NVDIMM`. `NVDIMM` is only valid for acceleration devices.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{stringvalidator.OneOf(
 					"HDD",
 					"SSD",
+					"NVDIMM",
 				)},
 			},
 			"external_acceleration_type": schema.StringAttribute{
@@ -175,6 +192,26 @@ func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				MarkdownDescription: "Original path of the device.",
 				Computed:            true,
 			},
+			"system_id": schema.StringAttribute{
+				Description: "ID of the PowerFlex system on which the device will be added." +
+					" Defaults to the system configured on the provider." +
+					" Cannot be updated.",
+				MarkdownDescription: "ID of the PowerFlex system on which the device will be added." +
+					" Defaults to the system configured on the provider." +
+					" Cannot be updated.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": TimeoutsBlock,
 		},
 	}
 }
@@ -184,17 +221,9 @@ func (r *deviceResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	r.client = req.ProviderData.(*goscaleio.Client)
-	system, err := helper.GetFirstSystem(r.client)
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error in getting system instance on the PowerFlex cluster",
-			err.Error(),
-		)
-		return
-	}
-	r.system = system
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
 }
 
 func (r *deviceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -231,30 +260,64 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	diags = r.getSdsID(&plan)
+	// bound the operation by the create timeout of the resource
+	createTimeout, diags := helper.GetCreateTimeout(plan.Timeouts)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	spInstance, diags = r.getStoragePoolID(&plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	system, err := helper.GetSystem(r.client, helper.SystemIDOrDefault(plan.SystemID, r.systemID), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
 		return
 	}
+	plan.SystemID = types.StringValue(system.System.ID)
 
-	deviceParam := &goscaleio_types.DeviceParam{
-		Name:                     plan.Name.ValueString(),
-		DeviceCurrentPathname:    plan.DevicePath.ValueString(),
-		SdsID:                    plan.SdsID.ValueString(),
-		StoragePoolID:            plan.StoragePoolID.ValueString(),
-		MediaType:                plan.MediaType.ValueString(),
-		ExternalAccelerationType: plan.ExternalAccelerationType.ValueString(),
+	diags = r.getSdsID(system, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	sp := goscaleio.NewStoragePoolEx(r.client, spInstance)
+	var (
+		deviceID string
+		err2     error
+	)
+	if !plan.AccelerationPoolID.IsNull() {
+		// acceleration devices belong to an acceleration pool instead of a storage pool
+		plan.StoragePoolID = types.StringNull()
+		plan.StoragePoolName = types.StringNull()
+		deviceID, err2 = client.AddAccelerationDevice(ctx, r.client, &client.AccelerationDeviceParam{
+			Name:                     plan.Name.ValueString(),
+			DeviceCurrentPathname:    plan.DevicePath.ValueString(),
+			SdsID:                    plan.SdsID.ValueString(),
+			AccelerationPoolID:       plan.AccelerationPoolID.ValueString(),
+			MediaType:                plan.MediaType.ValueString(),
+			ExternalAccelerationType: plan.ExternalAccelerationType.ValueString(),
+		})
+	} else {
+		spInstance, diags = r.getStoragePoolID(system, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	deviceID, err2 := sp.AttachDevice(deviceParam)
+		deviceParam := &goscaleio_types.DeviceParam{
+			Name:                     plan.Name.ValueString(),
+			DeviceCurrentPathname:    plan.DevicePath.ValueString(),
+			SdsID:                    plan.SdsID.ValueString(),
+			StoragePoolID:            plan.StoragePoolID.ValueString(),
+			MediaType:                plan.MediaType.ValueString(),
+			ExternalAccelerationType: plan.ExternalAccelerationType.ValueString(),
+		}
+		deviceID, err2 = goscaleio.NewStoragePoolEx(r.client, spInstance).AttachDevice(deviceParam)
+	}
 	if err2 != nil {
 		resp.Diagnostics.AddError(
 			"Error adding device with path: "+plan.DevicePath.ValueString(),
@@ -263,7 +326,7 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	deviceResponse, err3 := r.system.GetDevice(deviceID)
+	deviceResponse, err3 := system.GetDevice(deviceID)
 	if err3 != nil {
 		resp.Diagnostics.AddError(
 			"Error getting device with ID: "+deviceID,
@@ -272,6 +335,9 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// the device settings are changed through the device ID, they do not need the storage pool
+	sp := goscaleio.NewStoragePoolEx(r.client, spInstance)
+
 	if !plan.DeviceCapacity.IsNull() {
 		size := helper.ConvertToKB("GB", plan.DeviceCapacity.ValueInt64())
 
@@ -286,7 +352,7 @@ func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
-	deviceResponse, err3 = r.system.GetDevice(deviceID)
+	deviceResponse, err3 = system.GetDevice(deviceID)
 	if err3 != nil {
 		resp.Diagnostics.AddError(
 			"Error getting device with ID: "+deviceID,
@@ -313,7 +379,23 @@ func (r *deviceResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	deviceResponse, err3 := r.system.GetDevice(state.ID.ValueString())
+	system, err := helper.GetSystem(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+	state.SystemID = types.StringValue(system.System.ID)
+
+	deviceResponse, err3 := system.GetDevice(state.ID.ValueString())
+	// remove the device from the state when it has been removed outside of terraform
+	if helper.IsNotFoundError(err3) {
+		tflog.Warn(ctx, "[POWERFLEX] device "+state.ID.ValueString()+" not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err3 != nil {
 		resp.Diagnostics.AddError(
 			"Error getting device with ID: "+state.ID.ValueString(),
@@ -349,25 +431,56 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	diags = r.getSdsID(&plan)
+	// bound the operation by the update timeout of the resource
+	updateTimeout, diags := helper.GetUpdateTimeout(plan.Timeouts)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	system, err := helper.GetSystem(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+	plan.SystemID = types.StringValue(system.System.ID)
 
-	spInstance, diags = r.getStoragePoolID(&plan)
+	diags = r.getSdsID(system, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if !plan.StoragePoolID.IsUnknown() && plan.StoragePoolID.ValueString() != state.StoragePoolID.ValueString() {
+	if plan.AccelerationPoolID.ValueString() != state.AccelerationPoolID.ValueString() {
 		resp.Diagnostics.AddError(
-			"Storage pool ID cannot be updated",
-			"Storage pool ID cannot be updated")
+			"Acceleration pool ID cannot be updated",
+			"Acceleration pool ID cannot be updated")
 		return
 	}
 
+	if !plan.AccelerationPoolID.IsNull() {
+		plan.StoragePoolID = types.StringNull()
+		plan.StoragePoolName = types.StringNull()
+	} else {
+		spInstance, diags = r.getStoragePoolID(system, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !plan.StoragePoolID.IsUnknown() && plan.StoragePoolID.ValueString() != state.StoragePoolID.ValueString() {
+			resp.Diagnostics.AddError(
+				"Storage pool ID cannot be updated",
+				"Storage pool ID cannot be updated")
+			return
+		}
+	}
+
 	if !plan.SdsID.IsUnknown() && plan.SdsID.ValueString() != state.SdsID.ValueString() {
 		resp.Diagnostics.AddError(
 			"SDS ID cannot be updated",
@@ -444,7 +557,7 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
-	deviceResponse, err3 := r.system.GetDevice(state.ID.ValueString())
+	deviceResponse, err3 := system.GetDevice(state.ID.ValueString())
 	if err3 != nil {
 		resp.Diagnostics.AddError(
 			"Error getting device with ID: "+state.ID.ValueString(),
@@ -472,19 +585,65 @@ func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	sp, err := helper.GetStoragePoolType(r.client, state.StoragePoolID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error getting storage pool instance with ID: "+state.StoragePoolID.ValueString(),
-			"unexpected error: "+err.Error(),
-		)
+	// bound the operation by the delete timeout of the resource
+	deleteTimeout, diags := helper.GetDeleteTimeout(state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
-	err = sp.RemoveDevice(state.ID.ValueString())
+	var getDevices func() ([]goscaleio_types.Device, error)
+	if !state.AccelerationPoolID.IsNull() {
+		err := client.RemoveAccelerationDevice(ctx, r.client, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error removing device with ID: "+state.ID.ValueString(),
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+		getDevices = func() ([]goscaleio_types.Device, error) {
+			return client.GetAccelerationPoolDevices(ctx, r.client, state.AccelerationPoolID.ValueString())
+		}
+	} else {
+		sp, err := helper.GetStoragePoolType(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), state.StoragePoolID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting storage pool instance with ID: "+state.StoragePoolID.ValueString(),
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		err = sp.RemoveDevice(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error removing device with ID: "+state.ID.ValueString(),
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+		getDevices = sp.GetDevice
+	}
+
+	// device removal is asynchronous, wait till the device is gone from its pool
+	err := helper.WaitFor(ctx, helper.RemovalPollInterval, func() (bool, error) {
+		devices, err := getDevices()
+		if err != nil {
+			return false, err
+		}
+		for _, device := range devices {
+			if device.ID == state.ID.ValueString() {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error removing device with ID: "+state.ID.ValueString(),
+			"Error waiting for removal of device with ID: "+state.ID.ValueString(),
 			"unexpected error: "+err.Error(),
 		)
 		return
@@ -500,9 +659,9 @@ func (r *deviceResource) ImportState(ctx context.Context, req resource.ImportSta
 }
 
 // getSdsID populates the SDS ID in the plan
-func (r *deviceResource) getSdsID(plan *models.DeviceModel) (diags diag.Diagnostics) {
+func (r *deviceResource) getSdsID(system *goscaleio.System, plan *models.DeviceModel) (diags diag.Diagnostics) {
 	if !plan.SdsID.IsUnknown() {
-		sds, err := r.system.GetSdsByID(plan.SdsID.ValueString())
+		sds, err := system.GetSdsByID(plan.SdsID.ValueString())
 		if err != nil {
 			diags.AddError(
 				"Error in getting sds details with ID: "+plan.SdsID.ValueString(),
@@ -512,7 +671,7 @@ func (r *deviceResource) getSdsID(plan *models.DeviceModel) (diags diag.Diagnost
 		}
 		plan.SdsName = types.StringValue(sds.Name)
 	} else if !plan.SdsName.IsUnknown() {
-		sds, err := r.system.FindSds("Name", plan.SdsName.ValueString())
+		sds, err := system.FindSds("Name", plan.SdsName.ValueString())
 		if err != nil {
 			diags.AddError(
 				"Error in getting sds details with name: "+plan.SdsName.ValueString(),
@@ -526,14 +685,14 @@ func (r *deviceResource) getSdsID(plan *models.DeviceModel) (diags diag.Diagnost
 }
 
 // getStoragePoolID populates the storage pool ID in the plan
-func (r *deviceResource) getStoragePoolID(plan *models.DeviceModel) (sp *goscaleio_types.StoragePool, diags diag.Diagnostics) {
+func (r *deviceResource) getStoragePoolID(system *goscaleio.System, plan *models.DeviceModel) (sp *goscaleio_types.StoragePool, diags diag.Diagnostics) {
 	var (
 		pd  *goscaleio.ProtectionDomain
 		err error
 	)
 
 	if !plan.ProtectionDomainID.IsNull() || !plan.ProtectionDomainName.IsNull() {
-		pd, err = helper.GetNewProtectionDomainEx(r.client, plan.ProtectionDomainID.ValueString(), plan.ProtectionDomainName.ValueString(), "")
+		pd, err = helper.GetNewProtectionDomainEx(r.client, system.System.ID, plan.ProtectionDomainID.ValueString(), plan.ProtectionDomainName.ValueString(), "")
 		if err != nil {
 			diags.AddError(
 				"Error in getting protection domain details with ID: "+plan.ProtectionDomainID.ValueString()+" name: "+plan.ProtectionDomainName.ValueString(),
@@ -544,7 +703,7 @@ func (r *deviceResource) getStoragePoolID(plan *models.DeviceModel) (sp *goscale
 	}
 
 	if !plan.StoragePoolID.IsUnknown() {
-		sp, err = r.system.GetStoragePoolByID(plan.StoragePoolID.ValueString())
+		sp, err = system.GetStoragePoolByID(plan.StoragePoolID.ValueString())
 		if err != nil {
 			diags.AddError(
 				"Error in getting storage pool details with ID: "+plan.StoragePoolID.ValueString(),
@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &faultSetDataSource{}
+	_ datasource.DataSourceWithConfigure = &faultSetDataSource{}
+)
+
+// FaultSetDataSource returns the datasource for fault set
+func FaultSetDataSource() datasource.DataSource {
+	return &faultSetDataSource{}
+}
+
+type faultSetDataSource struct {
+	client *goscaleio.Client
+}
+
+func (d *faultSetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fault_set"
+}
+
+func (d *faultSetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = FaultSetDataSourceSchema
+}
+
+func (d *faultSetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	d.client = p.client
+}
+
+func (d *faultSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.FaultSetDataSourceModel
+	var faultSets []*client.FaultSet
+	var err error
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "[POWERFLEX] faultSetDataSourceModel"+helper.PrettyJSON((state)))
+
+	// Read the fault sets of the protection domain, or all the fault sets of the system
+	if !state.ProtectionDomainID.IsNull() {
+		faultSets, err = client.GetProtectionDomainFaultSets(ctx, d.client, state.ProtectionDomainID.ValueString())
+	} else {
+		faultSets, err = client.GetFaultSets(ctx, d.client)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Powerflex Fault Sets",
+			err.Error(),
+		)
+		return
+	}
+
+	state.FaultSets = []models.FaultSetModel{}
+	for _, faultSet := range faultSets {
+		if !state.ID.IsNull() && faultSet.ID != state.ID.ValueString() ||
+			!state.Name.IsNull() && faultSet.Name != state.Name.ValueString() {
+			continue
+		}
+		sdsList, err := client.GetFaultSetSds(ctx, d.client, faultSet.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read SDSs of Powerflex Fault Set "+faultSet.ID,
+				err.Error(),
+			)
+			return
+		}
+		state.FaultSets = append(state.FaultSets, helper.GetFaultSetState(faultSet, sdsList))
+	}
+
+	if !state.ID.IsNull() || !state.Name.IsNull() {
+		if len(state.FaultSets) == 0 {
+			filter := "id " + state.ID.ValueString()
+			if !state.Name.IsNull() {
+				filter = "name " + state.Name.ValueString()
+			}
+			resp.Diagnostics.AddError(
+				"Unable to Read Powerflex Fault Set",
+				"couldn't find fault set with "+filter,
+			)
+			return
+		}
+		// this is required for acceptance testing
+		state.ID = state.FaultSets[0].ID
+	} else {
+		// this is required for acceptance testing
+		state.ID = types.StringValue("DummyID")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
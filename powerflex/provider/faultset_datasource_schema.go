@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FaultSetDataSourceSchema defines the schema for fault set datasource
+var FaultSetDataSourceSchema schema.Schema = schema.Schema{
+	Description:         "This datasource can be used to fetch information related to fault sets from a PowerFlex array.",
+	MarkdownDescription: "This datasource can be used to fetch information related to fault sets from a PowerFlex array.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique identifier of the fault set instance to fetch." +
+				" Conflicts with 'name'.",
+			MarkdownDescription: "Unique identifier of the fault set instance to fetch." +
+				" Conflicts with `name`.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the fault set to fetch." +
+				" Conflicts with 'id'.",
+			MarkdownDescription: "Name of the fault set to fetch." +
+				" Conflicts with `id`.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"protection_domain_id": schema.StringAttribute{
+			Description:         "ID of the protection domain whose fault sets are fetched. All the fault sets of the system are fetched when not set.",
+			MarkdownDescription: "ID of the protection domain whose fault sets are fetched. All the fault sets of the system are fetched when not set.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"fault_sets": schema.ListNestedAttribute{
+			Description:         "List of fault sets fetched.",
+			MarkdownDescription: "List of fault sets fetched.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "Unique identifier of the fault set instance.",
+						MarkdownDescription: "Unique identifier of the fault set instance.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Name of the fault set.",
+						MarkdownDescription: "Name of the fault set.",
+						Computed:            true,
+					},
+					"protection_domain_id": schema.StringAttribute{
+						Description:         "ID of the protection domain of the fault set.",
+						MarkdownDescription: "ID of the protection domain of the fault set.",
+						Computed:            true,
+					},
+					"sds_ids": schema.ListAttribute{
+						Description:         "IDs of the SDSs placed in the fault set.",
+						MarkdownDescription: "IDs of the SDSs placed in the fault set.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
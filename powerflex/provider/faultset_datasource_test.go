@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var faultSetDataSourceConfig1 = createFaultSetPosTest + `
+data "powerflex_fault_set" "fs1" {
+	name = resource.powerflex_fault_set.fs.name
+}
+`
+
+var faultSetDataSourceConfig2 = createFaultSetPosTest + `
+data "powerflex_fault_set" "fs2" {
+	id = resource.powerflex_fault_set.fs.id
+}
+`
+
+var faultSetDataSourceConfig3 = createFaultSetPosTest + `
+data "powerflex_fault_set" "fs3" {
+	protection_domain_id = resource.powerflex_fault_set.fs.protection_domain_id
+}
+`
+
+var faultSetDataSourceConfig4 = `
+data "powerflex_fault_set" "fs4" {
+	name = "invalid-fault-set"
+}
+`
+
+func TestAccFaultSetDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfigForTesting + faultSetDataSourceConfig1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_fault_set.fs1", "fault_sets.#", "1"),
+					resource.TestCheckResourceAttr("data.powerflex_fault_set.fs1", "fault_sets.0.name", "tfacc-fault-set"),
+					resource.TestCheckResourceAttr("data.powerflex_fault_set.fs1", "fault_sets.0.protection_domain_id", protectionDomainID1),
+					resource.TestCheckResourceAttr("data.powerflex_fault_set.fs1", "fault_sets.0.sds_ids.#", "0"),
+					resource.TestCheckResourceAttrPair("data.powerflex_fault_set.fs1", "id", "powerflex_fault_set.fs", "id"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + faultSetDataSourceConfig2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_fault_set.fs2", "fault_sets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.powerflex_fault_set.fs2", "fault_sets.0.id", "powerflex_fault_set.fs", "id"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + faultSetDataSourceConfig3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.powerflex_fault_set.fs3", "fault_sets.0.id", "powerflex_fault_set.fs", "id"),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + faultSetDataSourceConfig4,
+				ExpectError: regexp.MustCompile(`.*couldn't find fault set with name invalid-fault-set*.`),
+			},
+		},
+	})
+}
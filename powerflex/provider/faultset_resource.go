@@ -0,0 +1,232 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &faultSetResource{}
+	_ resource.ResourceWithConfigure   = &faultSetResource{}
+	_ resource.ResourceWithImportState = &faultSetResource{}
+)
+
+// NewFaultSetResource is a helper function to simplify the provider implementation.
+func NewFaultSetResource() resource.Resource {
+	return &faultSetResource{}
+}
+
+// faultSetResource is the resource implementation.
+type faultSetResource struct {
+	client   *goscaleio.Client
+	systemID string
+}
+
+// Metadata returns the resource type name.
+func (r *faultSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fault_set"
+}
+
+// Schema defines the schema for the resource.
+func (r *faultSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = FaultSetResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *faultSetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *faultSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.FaultSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pdm, err := helper.GetNewProtectionDomainEx(r.client, helper.SystemIDOrDefault(plan.SystemID, r.systemID), plan.ProtectionDomainID.ValueString(), plan.ProtectionDomainName.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting Protection Domain",
+			err.Error(),
+		)
+		return
+	}
+
+	// set the protection domain name and system ID in the plan so that they get propagated to the state
+	plan.ProtectionDomainName = types.StringValue(pdm.ProtectionDomain.Name)
+	plan.SystemID = types.StringValue(pdm.ProtectionDomain.SystemID)
+
+	id, err := client.CreateFaultSet(ctx, r.client, &client.FaultSetCreateParam{
+		Name:               plan.Name.ValueString(),
+		ProtectionDomainID: pdm.ProtectionDomain.ID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating fault set",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "[POWERFLEX] fault set "+id+" created")
+
+	faultSet, err := client.GetFaultSet(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting fault set after creation",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	helper.UpdateFaultSetState(faultSet, &plan)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *faultSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.FaultSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	faultSet, err := client.GetFaultSet(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		// remove the fault set from the state when it has been deleted outside of terraform
+		if helper.IsNotFoundError(err) {
+			tflog.Warn(ctx, "[POWERFLEX] fault set "+state.ID.ValueString()+" not found, removing it from the state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error getting fault set",
+			"Could not get fault set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// the protection domain name and the system ID are not known when the fault set is imported
+	if state.ProtectionDomainName.IsNull() || state.SystemID.IsNull() {
+		system, err := helper.GetSystem(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), "")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in getting system instance on the PowerFlex cluster",
+				err.Error(),
+			)
+			return
+		}
+		state.SystemID = types.StringValue(system.System.ID)
+		protectionDomain, err := system.FindProtectionDomain(faultSet.ProtectionDomainID, "", "")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read name of protection domain of ID "+faultSet.ProtectionDomainID+" for fault set "+faultSet.Name,
+				err.Error(),
+			)
+			return
+		}
+		state.ProtectionDomainName = types.StringValue(protectionDomain.Name)
+	}
+
+	helper.UpdateFaultSetState(faultSet, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Only the name of the fault set can be updated, the other attributes require a replacement.
+func (r *faultSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.FaultSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var state models.FaultSetResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.ValueString() != state.Name.ValueString() {
+		if err := client.RenameFaultSet(ctx, r.client, state.ID.ValueString(), plan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error renaming fault set",
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	faultSet, err := client.GetFaultSet(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting fault set after update",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	helper.UpdateFaultSetState(faultSet, &plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *faultSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.FaultSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RemoveFaultSet(ctx, r.client, state.ID.ValueString())
+	if err != nil && !helper.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error removing fault set",
+			"Couldn't remove fault set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the fault set by its ID.
+func (r *faultSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// FaultSetResourceSchema variable to define schema for the fault set resource
+var FaultSetResourceSchema schema.Schema = schema.Schema{
+	Description:         "This resource can be used to manage fault sets on a PowerFlex array.",
+	MarkdownDescription: "This resource can be used to manage fault sets on a PowerFlex array.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the fault set.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the fault set.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the fault set, unique within its protection domain.",
+			Required:            true,
+			MarkdownDescription: "The name of the fault set, unique within its protection domain.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"protection_domain_id": schema.StringAttribute{
+			Description: "ID of the Protection Domain under which the fault set will be created." +
+				" Conflicts with 'protection_domain_name'." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "ID of the Protection Domain under which the fault set will be created." +
+				" Conflicts with `protection_domain_name`." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("protection_domain_name")),
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"protection_domain_name": schema.StringAttribute{
+			Description: "Name of the Protection Domain under which the fault set will be created." +
+				" Conflicts with 'protection_domain_id'." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "Name of the Protection Domain under which the fault set will be created." +
+				" Conflicts with `protection_domain_id`." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"system_id": schema.StringAttribute{
+			Description: "ID of the PowerFlex system on which the fault set will be created." +
+				" Defaults to the system configured on the provider." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "ID of the PowerFlex system on which the fault set will be created." +
+				" Defaults to the system configured on the provider." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	},
+}
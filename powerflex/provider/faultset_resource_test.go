@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var createFaultSetPosTest = `
+resource "powerflex_fault_set" "fs" {
+	name = "tfacc-fault-set"
+	protection_domain_name = "domain1"
+}
+`
+
+var updateFaultSetPosTest = `
+resource "powerflex_fault_set" "fs" {
+	name = "tfacc-fault-set-1"
+	protection_domain_name = "domain1"
+}
+`
+
+var createFaultSetInvalidPdTest = `
+resource "powerflex_fault_set" "fs-invalid" {
+	name = "tfacc-fault-set-invalid"
+	protection_domain_name = "invalid"
+}
+`
+
+var createFaultSetConflictTest = `
+resource "powerflex_fault_set" "fs-invalid" {
+	name = "tfacc-fault-set-invalid"
+	protection_domain_name = "domain1"
+	protection_domain_id = "` + protectionDomainID1 + `"
+}
+`
+
+func TestAccFaultSetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createFaultSetConflictTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			{
+				Config:      ProviderConfigForTesting + createFaultSetInvalidPdTest,
+				ExpectError: regexp.MustCompile(`.*Error getting Protection Domain*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createFaultSetPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_fault_set.fs", "name", "tfacc-fault-set"),
+					resource.TestCheckResourceAttr("powerflex_fault_set.fs", "protection_domain_name", "domain1"),
+					resource.TestCheckResourceAttr("powerflex_fault_set.fs", "protection_domain_id", protectionDomainID1),
+					resource.TestCheckResourceAttrSet("powerflex_fault_set.fs", "id"),
+					resource.TestCheckResourceAttrSet("powerflex_fault_set.fs", "system_id"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_fault_set.fs",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + updateFaultSetPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_fault_set.fs", "name", "tfacc-fault-set-1"),
+					resource.TestCheckResourceAttr("powerflex_fault_set.fs", "protection_domain_id", protectionDomainID1),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &mdmClusterDataSource{}
+	_ datasource.DataSourceWithConfigure = &mdmClusterDataSource{}
+)
+
+// MdmClusterDataSource returns the datasource for the MDM cluster
+func MdmClusterDataSource() datasource.DataSource {
+	return &mdmClusterDataSource{}
+}
+
+type mdmClusterDataSource struct {
+	client *goscaleio.Client
+}
+
+func (d *mdmClusterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdm_cluster"
+}
+
+func (d *mdmClusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = MdmClusterDataSourceSchema
+}
+
+func (d *mdmClusterDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	d.client = p.client
+}
+
+func (d *mdmClusterDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	cluster, err := client.GetMdmCluster(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Powerflex MDM Cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	state := helper.GetMdmClusterState(cluster)
+	tflog.Info(ctx, "[POWERFLEX] mdmClusterDataSourceModel"+helper.PrettyJSON(state))
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
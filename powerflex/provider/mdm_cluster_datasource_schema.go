@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MdmClusterDataSourceSchema defines the schema for MDM cluster datasource
+var MdmClusterDataSourceSchema schema.Schema = schema.Schema{
+	Description:         "This datasource can be used to fetch the topology of the MDM cluster of a PowerFlex array.",
+	MarkdownDescription: "This datasource can be used to fetch the topology of the MDM cluster of a PowerFlex array.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the MDM cluster.",
+			MarkdownDescription: "Unique identifier of the MDM cluster.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the MDM cluster.",
+			MarkdownDescription: "Name of the MDM cluster.",
+			Computed:            true,
+		},
+		"cluster_mode": schema.StringAttribute{
+			Description:         "Mode of the MDM cluster, like 'ThreeNodes' or 'FiveNodes'.",
+			MarkdownDescription: "Mode of the MDM cluster, like `ThreeNodes` or `FiveNodes`.",
+			Computed:            true,
+		},
+		"cluster_state": schema.StringAttribute{
+			Description:         "State of the MDM cluster, like 'ClusteredNormal' or 'ClusteredDegraded'.",
+			MarkdownDescription: "State of the MDM cluster, like `ClusteredNormal` or `ClusteredDegraded`.",
+			Computed:            true,
+		},
+		"good_nodes_num": schema.Int64Attribute{
+			Description:         "Number of members of the MDM cluster in a good state.",
+			MarkdownDescription: "Number of members of the MDM cluster in a good state.",
+			Computed:            true,
+		},
+		"good_replicas_num": schema.Int64Attribute{
+			Description:         "Number of replicas of the MDM repository in a good state.",
+			MarkdownDescription: "Number of replicas of the MDM repository in a good state.",
+			Computed:            true,
+		},
+		"primary_mdm": schema.SingleNestedAttribute{
+			Description:         "Primary MDM of the cluster.",
+			MarkdownDescription: "Primary MDM of the cluster.",
+			Computed:            true,
+			Attributes:          mdmDataSourceAttributes,
+		},
+		"secondary_mdm": schema.ListNestedAttribute{
+			Description:         "Secondary MDMs of the cluster.",
+			MarkdownDescription: "Secondary MDMs of the cluster.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: mdmDataSourceAttributes,
+			},
+		},
+		"tiebreaker_mdm": schema.ListNestedAttribute{
+			Description:         "Tie-breakers of the cluster.",
+			MarkdownDescription: "Tie-breakers of the cluster.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: mdmDataSourceAttributes,
+			},
+		},
+		"standby_mdm": schema.ListNestedAttribute{
+			Description:         "Standby MDMs of the cluster.",
+			MarkdownDescription: "Standby MDMs of the cluster.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: mdmDataSourceAttributes,
+			},
+		},
+	},
+}
+
+// mdmDataSourceAttributes defines the attributes of a member of the MDM cluster
+var mdmDataSourceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description:         "Unique identifier of the MDM.",
+		MarkdownDescription: "Unique identifier of the MDM.",
+		Computed:            true,
+	},
+	"name": schema.StringAttribute{
+		Description:         "Name of the MDM.",
+		MarkdownDescription: "Name of the MDM.",
+		Computed:            true,
+	},
+	"port": schema.Int64Attribute{
+		Description:         "Port of the MDM.",
+		MarkdownDescription: "Port of the MDM.",
+		Computed:            true,
+	},
+	"ips": schema.ListAttribute{
+		Description:         "IPs of the MDM.",
+		MarkdownDescription: "IPs of the MDM.",
+		ElementType:         types.StringType,
+		Computed:            true,
+	},
+	"management_ips": schema.ListAttribute{
+		Description:         "Management IPs of the MDM.",
+		MarkdownDescription: "Management IPs of the MDM.",
+		ElementType:         types.StringType,
+		Computed:            true,
+	},
+	"role": schema.StringAttribute{
+		Description:         "Role of the MDM, 'Manager' or 'TieBreaker'.",
+		MarkdownDescription: "Role of the MDM, `Manager` or `TieBreaker`.",
+		Computed:            true,
+	},
+	"status": schema.StringAttribute{
+		Description:         "Status of the MDM.",
+		MarkdownDescription: "Status of the MDM.",
+		Computed:            true,
+	},
+	"version_info": schema.StringAttribute{
+		Description:         "Software version of the MDM.",
+		MarkdownDescription: "Software version of the MDM.",
+		Computed:            true,
+	},
+}
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var mdmClusterDataSourceConfig = `
+data "powerflex_mdm_cluster" "cluster" {
+}
+`
+
+func TestAccMdmClusterDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfigForTesting + mdmClusterDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerflex_mdm_cluster.cluster", "id"),
+					resource.TestCheckResourceAttr("data.powerflex_mdm_cluster.cluster", "cluster_mode", "ThreeNodes"),
+					resource.TestCheckResourceAttr("data.powerflex_mdm_cluster.cluster", "cluster_state", "ClusteredNormal"),
+					resource.TestCheckResourceAttr("data.powerflex_mdm_cluster.cluster", "primary_mdm.ips.0", GatewayDataPoints.primaryMDMIP),
+					resource.TestCheckResourceAttr("data.powerflex_mdm_cluster.cluster", "primary_mdm.role", "Manager"),
+					resource.TestCheckResourceAttr("data.powerflex_mdm_cluster.cluster", "secondary_mdm.#", "1"),
+					resource.TestCheckResourceAttr("data.powerflex_mdm_cluster.cluster", "secondary_mdm.0.ips.0", GatewayDataPoints.secondaryMDMIP),
+					resource.TestCheckResourceAttr("data.powerflex_mdm_cluster.cluster", "tiebreaker_mdm.#", "1"),
+					resource.TestCheckResourceAttr("data.powerflex_mdm_cluster.cluster", "tiebreaker_mdm.0.ips.0", GatewayDataPoints.tbIP),
+					resource.TestCheckResourceAttr("data.powerflex_mdm_cluster.cluster", "tiebreaker_mdm.0.role", "TieBreaker"),
+				),
+			},
+		},
+	})
+}
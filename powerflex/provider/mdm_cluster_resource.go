@@ -0,0 +1,420 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &mdmClusterResource{}
+	_ resource.ResourceWithConfigure   = &mdmClusterResource{}
+	_ resource.ResourceWithImportState = &mdmClusterResource{}
+)
+
+// NewMdmClusterResource is a helper function to simplify the provider implementation.
+func NewMdmClusterResource() resource.Resource {
+	return &mdmClusterResource{}
+}
+
+// mdmClusterResource is the resource implementation.
+type mdmClusterResource struct {
+	client *goscaleio.Client
+}
+
+// Metadata returns the resource type name.
+func (r *mdmClusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdm_cluster"
+}
+
+// Schema defines the schema for the resource.
+func (r *mdmClusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = MdmClusterResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mdmClusterResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+}
+
+// Create brings the existing MDM cluster to the planned topology and sets the initial Terraform state.
+func (r *mdmClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.MdmClusterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateMdmCluster(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest topology of the MDM cluster.
+func (r *mdmClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.MdmClusterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := client.GetMdmCluster(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting MDM cluster",
+			"Could not get MDM cluster, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(helper.UpdateMdmClusterState(ctx, cluster, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update brings the MDM cluster to the planned topology and sets the updated Terraform state on success.
+func (r *mdmClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.MdmClusterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateMdmCluster(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the MDM cluster from the Terraform state, the cluster itself is left as is.
+func (r *mdmClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.MdmClusterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "[POWERFLEX] removing MDM cluster "+state.ID.ValueString()+" from the state, the cluster is not changed")
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the MDM cluster, whatever the given ID.
+func (r *mdmClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateMdmCluster brings the MDM cluster to the topology of the plan and saves the resulting topology in the plan.
+// The MDMs which are not members of the cluster are added as standby MDMs, then the primary MDM is switched,
+// the cluster mode is switched, the MDMs are renamed and finally the standby MDMs which are not planned are removed.
+func (r *mdmClusterResource) updateMdmCluster(ctx context.Context, plan *models.MdmClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	primary, dgs := helper.GetPrimaryMdmModel(ctx, plan.PrimaryMdm)
+	diags.Append(dgs...)
+	secondaries, dgs := helper.GetMdmModels(ctx, plan.SecondaryMdm)
+	diags.Append(dgs...)
+	tieBreakers, dgs := helper.GetMdmModels(ctx, plan.TieBreakerMdm)
+	diags.Append(dgs...)
+	standbys, dgs := helper.GetMdmModels(ctx, plan.StandbyMdm)
+	diags.Append(dgs...)
+	if diags.HasError() {
+		return diags
+	}
+
+	mode := plan.ClusterMode.ValueString()
+	required := helper.ClusterModeSecondaryMdms[mode]
+	if !plan.SecondaryMdm.IsUnknown() && len(secondaries) != required || !plan.TieBreakerMdm.IsUnknown() && len(tieBreakers) != required {
+		diags.AddError(
+			"Invalid MDM cluster topology",
+			fmt.Sprintf("the %s mode requires %d secondary MDMs and %d tie-breakers", mode, required, required),
+		)
+		return diags
+	}
+
+	cluster, err := client.GetMdmCluster(ctx, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error getting MDM cluster",
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	if mode != cluster.ClusterMode && (plan.SecondaryMdm.IsUnknown() || plan.TieBreakerMdm.IsUnknown()) {
+		diags.AddError(
+			"Invalid MDM cluster topology",
+			"secondary_mdm and tiebreaker_mdm are required to switch the cluster mode from "+cluster.ClusterMode+" to "+mode,
+		)
+		return diags
+	}
+
+	// add the MDMs which are not members of the cluster yet as standby MDMs
+	added := false
+	for _, group := range []struct {
+		mdms []models.MdmModel
+		role string
+	}{
+		{secondaries, client.MdmRoleManager},
+		{tieBreakers, client.MdmRoleTieBreaker},
+		{standbys, ""},
+	} {
+		for _, mdm := range group.mdms {
+			if helper.FindMdm(ctx, cluster, mdm) != nil {
+				continue
+			}
+			id, err := r.addStandbyMdm(ctx, mdm, group.role)
+			if err != nil {
+				diags.AddError(
+					"Error adding standby MDM",
+					"unexpected error: "+err.Error(),
+				)
+				return diags
+			}
+			tflog.Info(ctx, "[POWERFLEX] standby MDM "+id+" added")
+			added = true
+		}
+	}
+	if added {
+		if cluster, err = client.GetMdmCluster(ctx, r.client); err != nil {
+			diags.AddError(
+				"Error getting MDM cluster after adding standby MDMs",
+				"unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	// switch the primary MDM
+	primaryMdm := helper.FindMdm(ctx, cluster, primary)
+	if primaryMdm == nil {
+		diags.AddError(
+			"Error switching primary MDM",
+			"the primary MDM must be a member of the cluster",
+		)
+		return diags
+	}
+	if cluster.PrimaryMdm == nil || primaryMdm.ID != cluster.PrimaryMdm.ID {
+		if !containsMdm(cluster.SecondaryMdms, primaryMdm.ID) {
+			diags.AddError(
+				"Error switching primary MDM",
+				"only a secondary MDM can become the primary MDM, "+primaryMdm.ID+" is not a secondary MDM",
+			)
+			return diags
+		}
+		if err := client.ChangeMdmOwnership(ctx, r.client, primaryMdm.ID); err != nil {
+			diags.AddError(
+				"Error switching primary MDM",
+				"unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+		tflog.Info(ctx, "[POWERFLEX] MDM "+primaryMdm.ID+" is the new primary MDM")
+		if cluster, err = client.GetMdmCluster(ctx, r.client); err != nil {
+			diags.AddError(
+				"Error getting MDM cluster after switching primary MDM",
+				"unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	// switch the cluster mode, promoting and demoting the secondary MDMs and the tie-breakers
+	param := &client.SwitchClusterModeParam{Mode: mode}
+	if !plan.SecondaryMdm.IsUnknown() {
+		planned := r.plannedMdmIDs(ctx, cluster, secondaries)
+		param.AddSecondaryMdms = missingMdmIDs(planned, mdmIDs(cluster.SecondaryMdms))
+		param.RemoveSecondaryMdms = missingMdmIDs(mdmIDs(cluster.SecondaryMdms), planned)
+	}
+	if !plan.TieBreakerMdm.IsUnknown() {
+		planned := r.plannedMdmIDs(ctx, cluster, tieBreakers)
+		param.AddTBs = missingMdmIDs(planned, mdmIDs(cluster.TieBreakers))
+		param.RemoveTBs = missingMdmIDs(mdmIDs(cluster.TieBreakers), planned)
+	}
+	changed := len(param.AddSecondaryMdms)+len(param.RemoveSecondaryMdms)+len(param.AddTBs)+len(param.RemoveTBs) > 0
+	if mode == cluster.ClusterMode && changed {
+		diags.AddError(
+			"Error switching MDM cluster mode",
+			"the secondary MDMs and the tie-breakers can only change along with the cluster mode",
+		)
+		return diags
+	}
+	if mode != cluster.ClusterMode {
+		if err := client.SwitchClusterMode(ctx, r.client, param); err != nil {
+			diags.AddError(
+				"Error switching MDM cluster mode",
+				"unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+		tflog.Info(ctx, "[POWERFLEX] MDM cluster switched to "+mode+" mode")
+		if cluster, err = client.GetMdmCluster(ctx, r.client); err != nil {
+			diags.AddError(
+				"Error getting MDM cluster after switching cluster mode",
+				"unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	// rename the MDMs
+	all := append([]models.MdmModel{primary}, secondaries...)
+	all = append(all, tieBreakers...)
+	for _, mdm := range append(all, standbys...) {
+		if mdm.Name.IsUnknown() || mdm.Name.IsNull() {
+			continue
+		}
+		member := helper.FindMdm(ctx, cluster, mdm)
+		if member == nil || member.Name == mdm.Name.ValueString() {
+			continue
+		}
+		if err := client.RenameMdm(ctx, r.client, member.ID, mdm.Name.ValueString()); err != nil {
+			diags.AddError(
+				"Error renaming MDM "+member.ID,
+				"unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	// remove the standby MDMs which are not planned, including the demoted ones
+	if !plan.StandbyMdm.IsUnknown() {
+		planned := r.plannedMdmIDs(ctx, cluster, standbys)
+		for _, id := range missingMdmIDs(mdmIDs(cluster.StandbyMdms), planned) {
+			if err := client.RemoveStandbyMdm(ctx, r.client, id); err != nil {
+				diags.AddError(
+					"Error removing standby MDM "+id,
+					"unexpected error: "+err.Error(),
+				)
+				return diags
+			}
+			tflog.Info(ctx, "[POWERFLEX] standby MDM "+id+" removed")
+		}
+	}
+
+	cluster, err = client.GetMdmCluster(ctx, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error getting MDM cluster after update",
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	diags.Append(helper.UpdateMdmClusterState(ctx, cluster, plan)...)
+	return diags
+}
+
+// addStandbyMdm adds an MDM of the plan to the cluster as a standby MDM and returns its ID,
+// the role of the MDMs of the standby list is taken from the plan.
+func (r *mdmClusterResource) addStandbyMdm(ctx context.Context, mdm models.MdmModel, role string) (string, error) {
+	ips := helper.GetMdmIPs(ctx, mdm.IPs)
+	if len(ips) == 0 {
+		return "", fmt.Errorf("could not find MDM %s in the cluster, the IPs of the MDM are required to add it", mdm.ID.ValueString())
+	}
+	if role == "" {
+		if mdm.Role.IsUnknown() || mdm.Role.IsNull() {
+			return "", fmt.Errorf("the role of the MDM with IPs %v is required to add it", ips)
+		}
+		role = mdm.Role.ValueString()
+	}
+	param := &client.StandbyMdmParam{
+		IPs:           ips,
+		Role:          role,
+		ManagementIPs: helper.GetMdmIPs(ctx, mdm.ManagementIPs),
+		Name:          mdm.Name.ValueString(),
+	}
+	if !mdm.Port.IsUnknown() && !mdm.Port.IsNull() {
+		param.Port = strconv.FormatInt(mdm.Port.ValueInt64(), 10)
+	}
+	return client.AddStandbyMdm(ctx, r.client, param)
+}
+
+// plannedMdmIDs returns the IDs of the members of the cluster matching the MDMs of the plan.
+func (r *mdmClusterResource) plannedMdmIDs(ctx context.Context, cluster *client.MdmCluster, mdms []models.MdmModel) []string {
+	ids := []string{}
+	for _, mdm := range mdms {
+		if member := helper.FindMdm(ctx, cluster, mdm); member != nil {
+			ids = append(ids, member.ID)
+		}
+	}
+	return ids
+}
+
+// mdmIDs returns the IDs of members of the cluster.
+func mdmIDs(mdms []*client.Mdm) []string {
+	ids := []string{}
+	for _, mdm := range mdms {
+		ids = append(ids, mdm.ID)
+	}
+	return ids
+}
+
+// containsMdm reports whether the MDM is one of the members.
+func containsMdm(mdms []*client.Mdm, id string) bool {
+	for _, mdm := range mdms {
+		if mdm.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// missingMdmIDs returns the IDs of the first list which are not in the second one.
+func missingMdmIDs(ids, other []string) []string {
+	missing := []string{}
+	for _, id := range ids {
+		found := false
+		for _, o := range other {
+			if o == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MdmClusterResourceSchema variable to define schema for the MDM cluster resource
+var MdmClusterResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource can be used to manage the MDM cluster of a PowerFlex array: its mode, the role of its members and its standby MDMs." +
+		" The resource reads the current topology of the cluster on creation, it does not deploy MDMs.",
+	MarkdownDescription: "This resource can be used to manage the MDM cluster of a PowerFlex array: its mode, the role of its members and its standby MDMs." +
+		" The resource reads the current topology of the cluster on creation, it does not deploy MDMs.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the MDM cluster.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the MDM cluster.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"cluster_mode": schema.StringAttribute{
+			Description: "The mode of the MDM cluster. Valid values are 'OneNode', 'ThreeNodes' and 'FiveNodes'." +
+				" 'ThreeNodes' requires one secondary MDM and one tie-breaker, 'FiveNodes' requires two of each.",
+			Required: true,
+			MarkdownDescription: "The mode of the MDM cluster. Valid values are `OneNode`, `ThreeNodes` and `FiveNodes`." +
+				" `ThreeNodes` requires one secondary MDM and one tie-breaker, `FiveNodes` requires two of each.",
+			Validators: []validator.String{stringvalidator.OneOf(
+				client.ClusterModeOneNode,
+				client.ClusterModeThreeNodes,
+				client.ClusterModeFiveNodes,
+			)},
+		},
+		"cluster_state": schema.StringAttribute{
+			Description:         "The state of the MDM cluster, like 'ClusteredNormal' or 'ClusteredDegraded'.",
+			Computed:            true,
+			MarkdownDescription: "The state of the MDM cluster, like `ClusteredNormal` or `ClusteredDegraded`.",
+		},
+		"primary_mdm": schema.SingleNestedAttribute{
+			Description: "The primary MDM of the cluster." +
+				" Setting a secondary MDM here switches the primary and secondary roles.",
+			Required: true,
+			MarkdownDescription: "The primary MDM of the cluster." +
+				" Setting a secondary MDM here switches the primary and secondary roles.",
+			Attributes: mdmAttributes,
+		},
+		"secondary_mdm": schema.SetNestedAttribute{
+			Description: "The secondary MDMs of the cluster, required to switch the cluster mode." +
+				" The MDMs which are not members of the cluster yet are added as standby MDMs before switching the cluster mode.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The secondary MDMs of the cluster, required to switch the cluster mode." +
+				" The MDMs which are not members of the cluster yet are added as standby MDMs before switching the cluster mode.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: mdmAttributes,
+			},
+		},
+		"tiebreaker_mdm": schema.SetNestedAttribute{
+			Description: "The tie-breakers of the cluster, required to switch the cluster mode." +
+				" The MDMs which are not members of the cluster yet are added as standby MDMs before switching the cluster mode.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The tie-breakers of the cluster, required to switch the cluster mode." +
+				" The MDMs which are not members of the cluster yet are added as standby MDMs before switching the cluster mode.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: mdmAttributes,
+			},
+		},
+		"standby_mdm": schema.SetNestedAttribute{
+			Description: "The standby MDMs of the cluster. When set, the standby MDMs which are not listed are removed from the cluster." +
+				" 'role' is required to add a standby MDM.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The standby MDMs of the cluster. When set, the standby MDMs which are not listed are removed from the cluster." +
+				" `role` is required to add a standby MDM.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: mdmAttributes,
+			},
+		},
+	},
+}
+
+// mdmAttributes defines the attributes of a member of the MDM cluster,
+// an MDM of the configuration matches a member of the cluster by its ID or by one of its IPs.
+var mdmAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description:         "The ID of the MDM. Either 'id' or 'ips' identifies an MDM of the cluster.",
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The ID of the MDM. Either `id` or `ips` identifies an MDM of the cluster.",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("ips")),
+		},
+	},
+	"name": schema.StringAttribute{
+		Description:         "The name of the MDM, the MDM is renamed when it differs.",
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The name of the MDM, the MDM is renamed when it differs.",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"port": schema.Int64Attribute{
+		Description:         "The port of the MDM, only used when the MDM is added to the cluster. Default value is 9011.",
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The port of the MDM, only used when the MDM is added to the cluster. Default value is `9011`.",
+	},
+	"ips": schema.ListAttribute{
+		Description:         "The IPs of the MDM, required to add the MDM to the cluster.",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The IPs of the MDM, required to add the MDM to the cluster.",
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	},
+	"management_ips": schema.ListAttribute{
+		Description:         "The management IPs of the MDM, only used when a manager MDM is added to the cluster.",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The management IPs of the MDM, only used when a manager MDM is added to the cluster.",
+	},
+	"role": schema.StringAttribute{
+		Description: "The role of the MDM. Valid values are 'Manager' and 'TieBreaker'." +
+			" Managers can become primary or secondary MDMs, tie-breakers can only become tie-breakers.",
+		Optional: true,
+		Computed: true,
+		MarkdownDescription: "The role of the MDM. Valid values are `Manager` and `TieBreaker`." +
+			" Managers can become primary or secondary MDMs, tie-breakers can only become tie-breakers.",
+		Validators: []validator.String{stringvalidator.OneOf(
+			client.MdmRoleManager,
+			client.MdmRoleTieBreaker,
+		)},
+	},
+	"status": schema.StringAttribute{
+		Description:         "The status of the MDM.",
+		Computed:            true,
+		MarkdownDescription: "The status of the MDM.",
+	},
+}
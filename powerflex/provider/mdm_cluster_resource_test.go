@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// the standby MDMs are added to the cluster to expand it to five nodes, they are removed at the end of the test
+var standbyMdmIP = os.Getenv("POWERFLEX_STANDBY_MDM_IP")
+var standbyTbIP = os.Getenv("POWERFLEX_STANDBY_TB_IP")
+
+func mdmClusterConfig(mode, primaryIP, members string) string {
+	return `
+resource "powerflex_mdm_cluster" "cluster" {
+	cluster_mode = "` + mode + `"
+	primary_mdm = {
+		ips = ["` + primaryIP + `"]
+	}
+` + members + `
+}
+`
+}
+
+var threeNodesMdmMembers = `
+	secondary_mdm = [{ ips = ["` + GatewayDataPoints.secondaryMDMIP + `"] }]
+	tiebreaker_mdm = [{ ips = ["` + GatewayDataPoints.tbIP + `"] }]
+`
+
+var standbyMdmMembers = threeNodesMdmMembers + `
+	standby_mdm = [
+		{ ips = ["` + standbyMdmIP + `"], role = "Manager", name = "tfacc-standby-mdm" },
+		{ ips = ["` + standbyTbIP + `"], role = "TieBreaker" },
+	]
+`
+
+var fiveNodesMdmMembers = `
+	secondary_mdm = [
+		{ ips = ["` + GatewayDataPoints.secondaryMDMIP + `"] },
+		{ ips = ["` + standbyMdmIP + `"] },
+	]
+	tiebreaker_mdm = [
+		{ ips = ["` + GatewayDataPoints.tbIP + `"] },
+		{ ips = ["` + standbyTbIP + `"] },
+	]
+	standby_mdm = []
+`
+
+var switchedPrimaryMdmMembers = `
+	secondary_mdm = [
+		{ ips = ["` + GatewayDataPoints.primaryMDMIP + `"] },
+		{ ips = ["` + standbyMdmIP + `"] },
+	]
+	tiebreaker_mdm = [
+		{ ips = ["` + GatewayDataPoints.tbIP + `"] },
+		{ ips = ["` + standbyTbIP + `"] },
+	]
+	standby_mdm = []
+`
+
+var restoredMdmMembers = threeNodesMdmMembers + `
+	standby_mdm = []
+`
+
+func TestAccMdmClusterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + mdmClusterConfig("FiveNodes", GatewayDataPoints.primaryMDMIP, threeNodesMdmMembers),
+				ExpectError: regexp.MustCompile(`.*Invalid MDM cluster topology*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + mdmClusterConfig("ThreeNodes", GatewayDataPoints.primaryMDMIP, threeNodesMdmMembers),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "cluster_mode", "ThreeNodes"),
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "primary_mdm.ips.0", GatewayDataPoints.primaryMDMIP),
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "primary_mdm.role", "Manager"),
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "secondary_mdm.#", "1"),
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "tiebreaker_mdm.#", "1"),
+					resource.TestCheckResourceAttrSet("powerflex_mdm_cluster.cluster", "cluster_state"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_mdm_cluster.cluster",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// add the standby MDMs
+			{
+				Config: ProviderConfigForTesting + mdmClusterConfig("ThreeNodes", GatewayDataPoints.primaryMDMIP, standbyMdmMembers),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "standby_mdm.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerflex_mdm_cluster.cluster", "standby_mdm.*", map[string]string{
+						"name": "tfacc-standby-mdm",
+						"role": "Manager",
+					}),
+				),
+			},
+			// expand the cluster to five nodes
+			{
+				Config: ProviderConfigForTesting + mdmClusterConfig("FiveNodes", GatewayDataPoints.primaryMDMIP, fiveNodesMdmMembers),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "cluster_mode", "FiveNodes"),
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "secondary_mdm.#", "2"),
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "tiebreaker_mdm.#", "2"),
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "standby_mdm.#", "0"),
+				),
+			},
+			// switch the primary and secondary roles
+			{
+				Config: ProviderConfigForTesting + mdmClusterConfig("FiveNodes", GatewayDataPoints.secondaryMDMIP, switchedPrimaryMdmMembers),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "primary_mdm.ips.0", GatewayDataPoints.secondaryMDMIP),
+				),
+			},
+			// shrink the cluster back to three nodes with the original primary MDM, removing the standby MDMs
+			{
+				Config: ProviderConfigForTesting + mdmClusterConfig("ThreeNodes", GatewayDataPoints.primaryMDMIP, restoredMdmMembers),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "cluster_mode", "ThreeNodes"),
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "primary_mdm.ips.0", GatewayDataPoints.primaryMDMIP),
+					resource.TestCheckResourceAttr("powerflex_mdm_cluster.cluster", "standby_mdm.#", "0"),
+				),
+			},
+		},
+	})
+}
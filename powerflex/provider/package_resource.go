@@ -124,26 +124,15 @@ func (r *packageResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
-func (r *packageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+func (r *packageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
 
-	// Create a new PowerFlex gateway client using the configuration values
-	gatewayClient, err := goscaleio.NewGateway(r.client.GetConfigConnect().Endpoint, r.client.GetConfigConnect().Username, r.client.GetConfigConnect().Password, r.client.GetConfigConnect().Insecure, true)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Create gateway API Client",
-			"An unexpected error occurred when creating the gateway API client. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
-				"gateway Client Error: "+err.Error(),
-		)
-		return
-	}
-
-	r.gatewayClient = gatewayClient
+	r.gatewayClient = p.gatewayClient
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -0,0 +1,227 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &peerSystemResource{}
+	_ resource.ResourceWithConfigure   = &peerSystemResource{}
+	_ resource.ResourceWithImportState = &peerSystemResource{}
+)
+
+// NewPeerSystemResource is a helper function to simplify the provider implementation.
+func NewPeerSystemResource() resource.Resource {
+	return &peerSystemResource{}
+}
+
+// peerSystemResource is the resource implementation.
+type peerSystemResource struct {
+	client *goscaleio.Client
+}
+
+// Metadata returns the resource type name.
+func (r *peerSystemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peer_system"
+}
+
+// Schema defines the schema for the resource.
+func (r *peerSystemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = PeerSystemResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *peerSystemResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *peerSystemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.PeerSystemResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ips, diags := helper.GetPeerSystemIPs(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	param := &client.PeerMdmCreateParam{
+		Name:          plan.Name.ValueString(),
+		PeerSystemID:  plan.RemoteSystemID.ValueString(),
+		PeerSystemIps: ips,
+		PerfProfile:   plan.PerformanceProfile.ValueString(),
+	}
+	if !plan.Port.IsUnknown() && !plan.Port.IsNull() {
+		param.Port = strconv.FormatInt(plan.Port.ValueInt64(), 10)
+	}
+	id, err := client.CreatePeerMdm(ctx, r.client, param)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error registering peer system",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "[POWERFLEX] peer system "+id+" registered")
+
+	peerMdm, err := client.GetPeerMdm(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting peer system after registration",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(helper.UpdatePeerSystemState(peerMdm, &plan)...)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *peerSystemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.PeerSystemResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peerMdm, err := client.GetPeerMdm(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		// remove the peer system from the state when it has been unregistered outside of terraform
+		if helper.IsNotFoundError(err) {
+			tflog.Warn(ctx, "[POWERFLEX] peer system "+state.ID.ValueString()+" not found, removing it from the state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error getting peer system",
+			"Could not get peer system, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(helper.UpdatePeerSystemState(peerMdm, &state)...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *peerSystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.PeerSystemResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var state models.PeerSystemResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+	errMsg := make(map[string]string, 0)
+
+	if plan.Name.ValueString() != state.Name.ValueString() {
+		if err := client.RenamePeerMdm(ctx, r.client, id, plan.Name.ValueString()); err != nil {
+			errMsg["name"] = err.Error()
+		}
+	}
+
+	if !plan.IPList.Equal(state.IPList) {
+		ips, dgs := helper.GetPeerSystemIPs(ctx, plan)
+		resp.Diagnostics.Append(dgs...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := client.ModifyPeerMdmIPs(ctx, r.client, id, ips); err != nil {
+			errMsg["ip_list"] = err.Error()
+		}
+	}
+
+	if !plan.Port.IsUnknown() && plan.Port.ValueInt64() != state.Port.ValueInt64() {
+		if err := client.ModifyPeerMdmPort(ctx, r.client, id, int(plan.Port.ValueInt64())); err != nil {
+			errMsg["port"] = err.Error()
+		}
+	}
+
+	if !plan.PerformanceProfile.IsUnknown() && plan.PerformanceProfile.ValueString() != state.PerformanceProfile.ValueString() {
+		if err := client.ModifyPeerMdmPerfProfile(ctx, r.client, id, plan.PerformanceProfile.ValueString()); err != nil {
+			errMsg["performance_profile"] = err.Error()
+		}
+	}
+
+	peerMdm, err := client.GetPeerMdm(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting peer system after update",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(helper.UpdatePeerSystemState(peerMdm, &plan)...)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	addFailureMessage(&resp.Diagnostics, errMsg)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *peerSystemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.PeerSystemResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RemovePeerMdm(ctx, r.client, state.ID.ValueString())
+	if err != nil && !helper.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error unregistering peer system",
+			"Couldn't unregister peer system, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the peer system by its ID.
+func (r *peerSystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
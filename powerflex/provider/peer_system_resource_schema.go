@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PeerSystemResourceSchema variable to define schema for the peer system resource
+var PeerSystemResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource can be used to register the MDM of a remote PowerFlex system as a replication peer." +
+		" The peer system must be registered on both systems before replication consistency groups can be created.",
+	MarkdownDescription: "This resource can be used to register the MDM of a remote PowerFlex system as a replication peer." +
+		" The peer system must be registered on both systems before replication consistency groups can be created.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the peer system.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the peer system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the peer system.",
+			Required:            true,
+			MarkdownDescription: "The name of the peer system.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"remote_system_id": schema.StringAttribute{
+			Description:         "The ID of the remote PowerFlex system. Cannot be updated.",
+			Required:            true,
+			MarkdownDescription: "The ID of the remote PowerFlex system. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"ip_list": schema.ListAttribute{
+			Description:         "The IPs the MDM of the remote system is reached on.",
+			MarkdownDescription: "The IPs the MDM of the remote system is reached on.",
+			ElementType:         types.StringType,
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"port": schema.Int64Attribute{
+			Description:         "The port the MDM of the remote system is reached on. PowerFlex uses port 7611 when not set.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The port the MDM of the remote system is reached on. PowerFlex uses port 7611 when not set.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"performance_profile": schema.StringAttribute{
+			Description:         "The performance profile of the peer system. Valid values are 'HighPerformance' and 'Compact'.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The performance profile of the peer system. Valid values are `HighPerformance` and `Compact`.",
+			Validators: []validator.String{stringvalidator.OneOf(
+				"HighPerformance",
+				"Compact",
+			)},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"system_id": schema.StringAttribute{
+			Description:         "The ID of the local PowerFlex system the peer system is registered on.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the local PowerFlex system the peer system is registered on.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"software_version_info": schema.StringAttribute{
+			Description:         "The software version of the MDM of the remote system.",
+			Computed:            true,
+			MarkdownDescription: "The software version of the MDM of the remote system.",
+		},
+		"membership_state": schema.StringAttribute{
+			Description:         "The membership state of the peer system.",
+			Computed:            true,
+			MarkdownDescription: "The membership state of the peer system.",
+		},
+		"coupling_rc": schema.StringAttribute{
+			Description:         "The status of the connection to the remote system, 'SUCCESS' when it is connected.",
+			Computed:            true,
+			MarkdownDescription: "The status of the connection to the remote system, `SUCCESS` when it is connected.",
+		},
+	},
+}
@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// the remote system must be registered with the local system as a peer for the replication to work
+var replicationRemoteSystemID = os.Getenv("POWERFLEX_REPLICATION_REMOTE_SYSTEM_ID")
+var replicationRemoteMdmIP = os.Getenv("POWERFLEX_REPLICATION_REMOTE_MDM_IP")
+var replicationRemoteProtectionDomainID = os.Getenv("POWERFLEX_REPLICATION_REMOTE_PROTECTION_DOMAIN_ID")
+
+var createPeerSystemPosTest = `
+resource "powerflex_peer_system" "peer" {
+	name = "tfacc-peer-system"
+	remote_system_id = "` + replicationRemoteSystemID + `"
+	ip_list = ["` + replicationRemoteMdmIP + `"]
+}
+`
+
+var updatePeerSystemPosTest = `
+resource "powerflex_peer_system" "peer" {
+	name = "tfacc-peer-system-1"
+	remote_system_id = "` + replicationRemoteSystemID + `"
+	ip_list = ["` + replicationRemoteMdmIP + `"]
+	port = 7612
+	performance_profile = "Compact"
+}
+`
+
+var createPeerSystemEmptyIPsTest = `
+resource "powerflex_peer_system" "peer-invalid" {
+	name = "tfacc-peer-system-invalid"
+	remote_system_id = "` + replicationRemoteSystemID + `"
+	ip_list = []
+}
+`
+
+func TestAccPeerSystemResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createPeerSystemEmptyIPsTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createPeerSystemPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_peer_system.peer", "name", "tfacc-peer-system"),
+					resource.TestCheckResourceAttr("powerflex_peer_system.peer", "remote_system_id", replicationRemoteSystemID),
+					resource.TestCheckResourceAttr("powerflex_peer_system.peer", "ip_list.#", "1"),
+					resource.TestCheckResourceAttr("powerflex_peer_system.peer", "ip_list.0", replicationRemoteMdmIP),
+					resource.TestCheckResourceAttr("powerflex_peer_system.peer", "port", "7611"),
+					resource.TestCheckResourceAttrSet("powerflex_peer_system.peer", "id"),
+					resource.TestCheckResourceAttrSet("powerflex_peer_system.peer", "system_id"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_peer_system.peer",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + updatePeerSystemPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_peer_system.peer", "name", "tfacc-peer-system-1"),
+					resource.TestCheckResourceAttr("powerflex_peer_system.peer", "port", "7612"),
+					resource.TestCheckResourceAttr("powerflex_peer_system.peer", "performance_profile", "Compact"),
+				),
+			},
+		},
+	})
+}
@@ -42,7 +42,8 @@ func ProtectionDomainDataSource() datasource.DataSource {
 }
 
 type protectionDomainDataSource struct {
-	client *goscaleio.Client
+	client   *goscaleio.Client
+	systemID string
 }
 
 func (d *protectionDomainDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -58,7 +59,9 @@ func (d *protectionDomainDataSource) Configure(_ context.Context, req datasource
 		return
 	}
 
-	d.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	d.client = p.client
+	d.systemID = p.systemID
 }
 
 func (d *protectionDomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -69,7 +72,7 @@ func (d *protectionDomainDataSource) Read(ctx context.Context, req datasource.Re
 	resp.Diagnostics.Append(diags...)
 	tflog.Info(ctx, "[POWERFLEX] protectionDomainDataSourceModel"+helper.PrettyJSON((state)))
 
-	system, err := helper.GetFirstSystem(d.client)
+	system, err := helper.GetSystem(d.client, d.systemID, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Powerflex System",
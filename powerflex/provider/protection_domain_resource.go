@@ -46,7 +46,7 @@ func NewProtectionDomainResource() resource.Resource {
 
 type protectionDomainResource struct {
 	client   *goscaleio.Client
-	system   *goscaleio.System
+	systemID string
 	pdClient *goscaleio.ProtectionDomain
 }
 
@@ -140,17 +140,9 @@ func (d *protectionDomainResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	d.client = req.ProviderData.(*goscaleio.Client)
-
-	system, err := helper.GetFirstSystem(d.client)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Powerflex System",
-			err.Error(),
-		)
-		return
-	}
-	d.system = system
+	p := req.ProviderData.(*powerflexProvider)
+	d.client = p.client
+	d.systemID = p.systemID
 }
 
 // ConfigurePdState receives the previous state and builds the protection domain client internally
@@ -174,6 +166,12 @@ func (d *protectionDomainResource) Read(ctx context.Context, req resource.ReadRe
 	// Fetch protection domain of given id
 	resp.Diagnostics.Append(d.ConfigurePdState(ctx, state)...)
 	newState, err := d.ReadByID()
+	// remove the protection domain from the state when it has been deleted outside of terraform
+	if helper.IsNotFoundError(err) {
+		tflog.Warn(ctx, "[POWERFLEX] protection domain "+state.ID.ValueString()+" not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to Read Powerflex ProtectionDomain of ID %s", state.ID.ValueString()),
@@ -197,7 +195,16 @@ func (d *protectionDomainResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	id, err := d.system.CreateProtectionDomain(plan.Name.ValueString())
+	system, err := helper.GetSystem(d.client, helper.SystemIDOrDefault(plan.SystemID, d.systemID), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Powerflex System",
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := system.CreateProtectionDomain(plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating protection domain",
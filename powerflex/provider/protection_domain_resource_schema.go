@@ -232,5 +232,22 @@ var ProtectionDomainResourceSchema schema.Schema = schema.Schema{
 				},
 			},
 		},
+		"system_id": schema.StringAttribute{
+			Description: "ID of the PowerFlex system on which the protection domain will be created." +
+				" Defaults to the system configured on the provider." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "ID of the PowerFlex system on which the protection domain will be created." +
+				" Defaults to the system configured on the provider." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
 	},
 }
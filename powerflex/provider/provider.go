@@ -21,13 +21,20 @@ import (
 	"context"
 	"os"
 	"strconv"
+	"time"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
 
 	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -41,15 +48,42 @@ func New() provider.Provider {
 	return &powerflexProvider{}
 }
 
-type powerflexProvider struct{}
+// powerflexProvider - provider definition, also handed to the resources and
+// datasources as provider data once configured.
+type powerflexProvider struct {
+	client *goscaleio.Client
+	// systemID is the ID of the PowerFlex system the resources and datasources
+	// work on by default. It is empty when the gateway manages a single system.
+	systemID string
+	// gatewayClient is the client of the installer gateway, used to upload packages and install SDCs.
+	gatewayClient *goscaleio.GatewayClient
+}
 
 // powerflexProviderModel - provider input struct.
 type powerflexProviderModel struct {
+	EndPoint   types.String  `tfsdk:"endpoint"`
+	Username   types.String  `tfsdk:"username"`
+	Password   types.String  `tfsdk:"password"`
+	Insecure   types.Bool    `tfsdk:"insecure"`
+	Timeout    types.Int64   `tfsdk:"timeout"`
+	SystemID   types.String  `tfsdk:"system_id"`
+	SystemName types.String  `tfsdk:"system_name"`
+	MaxRetries types.Int64   `tfsdk:"max_retries"`
+	MinBackoff types.String  `tfsdk:"retry_min_backoff"`
+	MaxBackoff types.String  `tfsdk:"retry_max_backoff"`
+	CACert     types.String  `tfsdk:"ca_certificate"`
+	ClientCert types.String  `tfsdk:"client_certificate"`
+	ClientKey  types.String  `tfsdk:"client_key"`
+	Gateway    *gatewayModel `tfsdk:"gateway"`
+}
+
+// gatewayModel - installer gateway input struct, the attributes which are not set
+// default to the ones of the REST API.
+type gatewayModel struct {
 	EndPoint types.String `tfsdk:"endpoint"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
 	Insecure types.Bool   `tfsdk:"insecure"`
-	Timeout  types.Int64  `tfsdk:"timeout"`
 }
 
 // Metadata - provider metadata AKA name.
@@ -86,11 +120,162 @@ func (p *powerflexProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				MarkdownDescription: "Specifies if the user wants to skip SSL verification.",
 				Optional:            true,
 			},
+			"ca_certificate": schema.StringAttribute{
+				Description: "PEM encoded CA certificates, or the path of a file holding them, used to verify the certificate of the PowerFlex Gateway" +
+					" in addition to the system certificates. Also used for the installer gateway." +
+					" Can also be set with the POWERFLEX_CA_CERTIFICATE environment variable.",
+				MarkdownDescription: "PEM encoded CA certificates, or the path of a file holding them, used to verify the certificate of the PowerFlex Gateway" +
+					" in addition to the system certificates. Also used for the installer gateway." +
+					" Can also be set with the `POWERFLEX_CA_CERTIFICATE` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_certificate": schema.StringAttribute{
+				Description: "PEM encoded client certificate, or the path of a file holding it, presented to the PowerFlex Gateway." +
+					" Requires 'client_key'." +
+					" Can also be set with the POWERFLEX_CLIENT_CERTIFICATE environment variable.",
+				MarkdownDescription: "PEM encoded client certificate, or the path of a file holding it, presented to the PowerFlex Gateway." +
+					" Requires `client_key`." +
+					" Can also be set with the `POWERFLEX_CLIENT_CERTIFICATE` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate, or the path of a file holding it." +
+					" Requires 'client_certificate'." +
+					" Can also be set with the POWERFLEX_CLIENT_KEY environment variable.",
+				MarkdownDescription: "PEM encoded private key of the client certificate, or the path of a file holding it." +
+					" Requires `client_certificate`." +
+					" Can also be set with the `POWERFLEX_CLIENT_KEY` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_certificate")),
+				},
+			},
 			"timeout": schema.Int64Attribute{
 				Description:         "HTTPS timeout.",
 				MarkdownDescription: "HTTPS timeout.",
 				Optional:            true,
 			},
+			"system_id": schema.StringAttribute{
+				Description: "ID of the PowerFlex system to manage, when the gateway manages more than one system." +
+					" Conflicts with 'system_name'." +
+					" Can also be set with the POWERFLEX_SYSTEM_ID environment variable.",
+				MarkdownDescription: "ID of the PowerFlex system to manage, when the gateway manages more than one system." +
+					" Conflicts with `system_name`." +
+					" Can also be set with the `POWERFLEX_SYSTEM_ID` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("system_name")),
+				},
+			},
+			"system_name": schema.StringAttribute{
+				Description: "Name of the PowerFlex system to manage, when the gateway manages more than one system." +
+					" Conflicts with 'system_id'." +
+					" Can also be set with the POWERFLEX_SYSTEM_NAME environment variable.",
+				MarkdownDescription: "Name of the PowerFlex system to manage, when the gateway manages more than one system." +
+					" Conflicts with `system_id`." +
+					" Can also be set with the `POWERFLEX_SYSTEM_NAME` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("system_id")),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Number of times a request failing with a transient error, like an MDM failover or an HTTP 503 from the gateway, is retried." +
+					" Requests which are not idempotent are only retried when PowerFlex did not process them." +
+					" Set to 0 to disable retries. Default value is 3." +
+					" Can also be set with the POWERFLEX_MAX_RETRIES environment variable.",
+				MarkdownDescription: "Number of times a request failing with a transient error, like an MDM failover or an HTTP 503 from the gateway, is retried." +
+					" Requests which are not idempotent are only retried when PowerFlex did not process them." +
+					" Set to `0` to disable retries. Default value is `3`." +
+					" Can also be set with the `POWERFLEX_MAX_RETRIES` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				Description: "Delay before the first retry of a failed request, like '500ms' or '2s'. The delay doubles after every retry." +
+					" Default value is '1s'." +
+					" Can also be set with the POWERFLEX_RETRY_MIN_BACKOFF environment variable.",
+				MarkdownDescription: "Delay before the first retry of a failed request, like `500ms` or `2s`. The delay doubles after every retry." +
+					" Default value is `1s`." +
+					" Can also be set with the `POWERFLEX_RETRY_MIN_BACKOFF` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					helper.DurationValidator{},
+				},
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Description: "Upper bound of the delay between two retries of a failed request, like '10s' or '1m'." +
+					" Default value is '30s'." +
+					" Can also be set with the POWERFLEX_RETRY_MAX_BACKOFF environment variable.",
+				MarkdownDescription: "Upper bound of the delay between two retries of a failed request, like `10s` or `1m`." +
+					" Default value is `30s`." +
+					" Can also be set with the `POWERFLEX_RETRY_MAX_BACKOFF` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					helper.DurationValidator{},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"gateway": schema.SingleNestedBlock{
+				Description: "Installer gateway used by the package and SDC resources, when it does not run on the same host" +
+					" or with the same credentials as the REST API. Each attribute which is not set defaults to the matching provider attribute.",
+				MarkdownDescription: "Installer gateway used by the package and SDC resources, when it does not run on the same host" +
+					" or with the same credentials as the REST API. Each attribute which is not set defaults to the matching provider attribute.",
+				Attributes: map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						Description: "The installer gateway server URL (inclusive of the port)." +
+							" Can also be set with the POWERFLEX_GATEWAY_ENDPOINT environment variable.",
+						MarkdownDescription: "The installer gateway server URL (inclusive of the port)." +
+							" Can also be set with the `POWERFLEX_GATEWAY_ENDPOINT` environment variable.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"username": schema.StringAttribute{
+						Description: "The username of the installer gateway." +
+							" Can also be set with the POWERFLEX_GATEWAY_USERNAME environment variable.",
+						MarkdownDescription: "The username of the installer gateway." +
+							" Can also be set with the `POWERFLEX_GATEWAY_USERNAME` environment variable.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"password": schema.StringAttribute{
+						Description: "The password of the installer gateway." +
+							" Can also be set with the POWERFLEX_GATEWAY_PASSWORD environment variable.",
+						MarkdownDescription: "The password of the installer gateway." +
+							" Can also be set with the `POWERFLEX_GATEWAY_PASSWORD` environment variable.",
+						Optional:  true,
+						Sensitive: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"insecure": schema.BoolAttribute{
+						Description: "Specifies if the user wants to skip SSL verification of the installer gateway." +
+							" Can also be set with the POWERFLEX_GATEWAY_INSECURE environment variable.",
+						MarkdownDescription: "Specifies if the user wants to skip SSL verification of the installer gateway." +
+							" Can also be set with the `POWERFLEX_GATEWAY_INSECURE` environment variable.",
+						Optional: true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -142,6 +327,8 @@ func (p *powerflexProvider) Configure(ctx context.Context, req provider.Configur
 	username := os.Getenv("POWERFLEX_USERNAME")
 	password := os.Getenv("POWERFLEX_PASSWORD")
 	insecure := os.Getenv("POWERFLEX_INSECURE") == "true"
+	systemID := os.Getenv("POWERFLEX_SYSTEM_ID")
+	systemName := os.Getenv("POWERFLEX_SYSTEM_NAME")
 	if os.Getenv("POWERFLEX_TIMEOUT") != "" {
 		var err error
 		timeout, err = strconv.Atoi(os.Getenv("POWERFLEX_TIMEOUT"))
@@ -149,6 +336,19 @@ func (p *powerflexProvider) Configure(ctx context.Context, req provider.Configur
 			resp.Diagnostics.AddError("Invalid POWERFLEX_TIMEOUT", err.Error())
 		}
 	}
+	clientConfig := client.DefaultConfig()
+	if os.Getenv("POWERFLEX_MAX_RETRIES") != "" {
+		var err error
+		clientConfig.MaxRetries, err = strconv.Atoi(os.Getenv("POWERFLEX_MAX_RETRIES"))
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid POWERFLEX_MAX_RETRIES", err.Error())
+		}
+	}
+	clientConfig.CACertificate = os.Getenv("POWERFLEX_CA_CERTIFICATE")
+	clientConfig.ClientCertificate = os.Getenv("POWERFLEX_CLIENT_CERTIFICATE")
+	clientConfig.ClientKey = os.Getenv("POWERFLEX_CLIENT_KEY")
+	minBackoff := os.Getenv("POWERFLEX_RETRY_MIN_BACKOFF")
+	maxBackoff := os.Getenv("POWERFLEX_RETRY_MAX_BACKOFF")
 
 	if !config.EndPoint.IsNull() {
 		endpoint = config.EndPoint.ValueString()
@@ -167,6 +367,79 @@ func (p *powerflexProvider) Configure(ctx context.Context, req provider.Configur
 	if !config.Timeout.IsNull() {
 		timeout = int(config.Timeout.ValueInt64())
 	}
+	if !config.CACert.IsNull() {
+		clientConfig.CACertificate = config.CACert.ValueString()
+	}
+	// the client certificate and its key always come from the same source
+	if !config.ClientCert.IsNull() || !config.ClientKey.IsNull() {
+		clientConfig.ClientCertificate = config.ClientCert.ValueString()
+		clientConfig.ClientKey = config.ClientKey.ValueString()
+	}
+	if !config.MaxRetries.IsNull() {
+		clientConfig.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.MinBackoff.IsNull() {
+		minBackoff = config.MinBackoff.ValueString()
+	}
+	if !config.MaxBackoff.IsNull() {
+		maxBackoff = config.MaxBackoff.ValueString()
+	}
+	if minBackoff != "" {
+		var err error
+		clientConfig.MinBackoff, err = time.ParseDuration(minBackoff)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("retry_min_backoff"), "Invalid retry minimum backoff", err.Error())
+		}
+	}
+	if maxBackoff != "" {
+		var err error
+		clientConfig.MaxBackoff, err = time.ParseDuration(maxBackoff)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("retry_max_backoff"), "Invalid retry maximum backoff", err.Error())
+		}
+	}
+	if clientConfig.MaxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_retries"), "Invalid maximum retries", "max_retries must not be negative")
+	}
+	if clientConfig.MaxBackoff < clientConfig.MinBackoff {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_max_backoff"), "Invalid retry maximum backoff", "retry_max_backoff must not be lower than retry_min_backoff")
+	}
+	// the installer gateway defaults to the REST API endpoint and credentials
+	gatewayEndpoint := endpoint
+	gatewayUsername := username
+	gatewayPassword := password
+	gatewayInsecure := insecure
+	if v := os.Getenv("POWERFLEX_GATEWAY_ENDPOINT"); v != "" {
+		gatewayEndpoint = v
+	}
+	if v := os.Getenv("POWERFLEX_GATEWAY_USERNAME"); v != "" {
+		gatewayUsername = v
+	}
+	if v := os.Getenv("POWERFLEX_GATEWAY_PASSWORD"); v != "" {
+		gatewayPassword = v
+	}
+	if v := os.Getenv("POWERFLEX_GATEWAY_INSECURE"); v != "" {
+		gatewayInsecure = v == "true"
+	}
+	if config.Gateway != nil {
+		if !config.Gateway.EndPoint.IsNull() {
+			gatewayEndpoint = config.Gateway.EndPoint.ValueString()
+		}
+		if !config.Gateway.Username.IsNull() {
+			gatewayUsername = config.Gateway.Username.ValueString()
+		}
+		if !config.Gateway.Password.IsNull() {
+			gatewayPassword = config.Gateway.Password.ValueString()
+		}
+		if !config.Gateway.Insecure.IsNull() {
+			gatewayInsecure = config.Gateway.Insecure.ValueBool()
+		}
+	}
+	// system set in the configuration takes precedence over both environment variables
+	if !config.SystemID.IsNull() || !config.SystemName.IsNull() {
+		systemID = config.SystemID.ValueString()
+		systemName = config.SystemName.ValueString()
+	}
 
 	if endpoint == "" {
 		resp.Diagnostics.AddAttributeError(
@@ -208,10 +481,22 @@ func (p *powerflexProvider) Configure(ctx context.Context, req provider.Configur
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "powerflex_password")
 	ctx = tflog.SetField(ctx, "insecure", insecure)
 	ctx = tflog.SetField(ctx, "timeout", timeout)
+	ctx = tflog.SetField(ctx, "gateway_endpoint", gatewayEndpoint)
+	ctx = tflog.SetField(ctx, "gateway_username", gatewayUsername)
+	ctx = tflog.SetField(ctx, "gateway_password", gatewayPassword)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "gateway_password")
+	ctx = tflog.SetField(ctx, "gateway_insecure", gatewayInsecure)
+	ctx = tflog.SetField(ctx, "system_id", systemID)
+	ctx = tflog.SetField(ctx, "system_name", systemName)
+	ctx = tflog.SetField(ctx, "ca_certificate_set", clientConfig.CACertificate != "")
+	ctx = tflog.SetField(ctx, "client_certificate_set", clientConfig.ClientCertificate != "")
+	ctx = tflog.SetField(ctx, "max_retries", clientConfig.MaxRetries)
+	ctx = tflog.SetField(ctx, "retry_min_backoff", clientConfig.MinBackoff.String())
+	ctx = tflog.SetField(ctx, "retry_max_backoff", clientConfig.MaxBackoff.String())
 	tflog.Debug(ctx, "Creating powerflex client")
 
 	// Create a new powerflex client using the configuration values
-	Client, err := goscaleio.NewClientWithArgs(endpoint, "", int64(timeout), insecure, true)
+	Client, err := client.NewClient(endpoint, int64(timeout), insecure, clientConfig)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create powerflex API Client",
@@ -241,8 +526,36 @@ func (p *powerflexProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	resp.DataSourceData = Client
-	resp.ResourceData = Client
+	// Create a new PowerFlex gateway client using the configuration values
+	gatewayClient, err := client.NewGateway(gatewayEndpoint, gatewayUsername, gatewayPassword, gatewayInsecure, clientConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create gateway API Client",
+			"An unexpected error occurred when creating the gateway API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"gateway Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	p.client = Client
+	p.gatewayClient = gatewayClient
+	p.systemID = ""
+	if systemID != "" || systemName != "" {
+		system, err := helper.GetSystem(Client, systemID, systemName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to find the PowerFlex system",
+				"An unexpected error occurred when finding the PowerFlex system to manage.\n\n"+
+					"powerflex Client Error: "+err.Error(),
+			)
+			return
+		}
+		p.systemID = system.System.ID
+	}
+
+	resp.DataSourceData = p
+	resp.ResourceData = p
 
 	tflog.Info(ctx, "Configured powerflex client", map[string]any{"success": true})
 }
@@ -257,6 +570,9 @@ func (p *powerflexProvider) DataSources(_ context.Context) []func() datasource.D
 		SnapshotPolicyDataSource,
 		SDSDataSource,
 		DeviceDataSource,
+		FaultSetDataSource,
+		MdmClusterDataSource,
+		UserDataSource,
 	}
 }
 
@@ -265,8 +581,23 @@ func (p *powerflexProvider) Resources(_ context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		NewProtectionDomainResource,
 		NewSDSResource,
+		NewFaultSetResource,
 		NewVolumeResource,
 		NewSnapshotResource,
+		NewSnapshotGroupResource,
+		NewSnapshotPolicyResource,
+		NewPeerSystemResource,
+		NewReplicationConsistencyGroupResource,
+		NewReplicationPairResource,
+		NewReplicationConsistencyGroupActionResource,
+		NewMdmClusterResource,
+		NewUserResource,
+		NewSystemResource,
+		NewSdcApprovalResource,
+		NewAccelerationPoolResource,
+		NewVolumeCloneResource,
+		NewVolumeRestoreResource,
+		NewVolumeSdcMappingResource,
 		SDCResource,
 		StoragepoolResource,
 		NewSDCVolumesMappingResource,
@@ -0,0 +1,266 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &replicationConsistencyGroupActionResource{}
+	_ resource.ResourceWithConfigure = &replicationConsistencyGroupActionResource{}
+)
+
+// NewReplicationConsistencyGroupActionResource is a helper function to simplify the provider implementation.
+func NewReplicationConsistencyGroupActionResource() resource.Resource {
+	return &replicationConsistencyGroupActionResource{}
+}
+
+// replicationConsistencyGroupActionResource is the resource implementation.
+type replicationConsistencyGroupActionResource struct {
+	client *goscaleio.Client
+}
+
+// Metadata returns the resource type name.
+func (r *replicationConsistencyGroupActionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_consistency_group_action"
+}
+
+// Schema defines the schema for the resource.
+func (r *replicationConsistencyGroupActionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ReplicationConsistencyGroupActionResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *replicationConsistencyGroupActionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+}
+
+// Create runs the action on the replication consistency group and sets the initial Terraform state.
+func (r *replicationConsistencyGroupActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.ReplicationConsistencyGroupActionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the action and its polling by the create timeout of the resource
+	createTimeout, diags := helper.GetCreateTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.runAction(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest state of the replication consistency group.
+func (r *replicationConsistencyGroupActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.ReplicationConsistencyGroupActionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rcg, err := r.client.GetReplicationConsistencyGroupByID(state.ReplicationConsistencyGroupID.ValueString())
+	if err != nil {
+		// remove the action from the state when the replication consistency group has been deleted
+		if helper.IsNotFoundError(err) {
+			tflog.Warn(ctx, "[POWERFLEX] replication consistency group "+state.ReplicationConsistencyGroupID.ValueString()+" not found, removing the action from the state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error getting replication consistency group",
+			"Could not get replication consistency group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	helper.UpdateReplicationConsistencyGroupActionState(rcg, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update runs the action again when it has changed and sets the updated Terraform state on success.
+func (r *replicationConsistencyGroupActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.ReplicationConsistencyGroupActionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var state models.ReplicationConsistencyGroupActionResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the action and its polling by the update timeout of the resource
+	updateTimeout, diags := helper.GetUpdateTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if plan.Action.ValueString() != state.Action.ValueString() {
+		resp.Diagnostics.Append(r.runAction(ctx, &plan)...)
+	} else {
+		resp.Diagnostics.Append(r.readAction(ctx, &plan)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the action from the Terraform state, the replication consistency group is left as is.
+func (r *replicationConsistencyGroupActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.ReplicationConsistencyGroupActionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "[POWERFLEX] removing action "+state.Action.ValueString()+" of replication consistency group "+state.ReplicationConsistencyGroupID.ValueString()+" from the state")
+	resp.State.RemoveResource(ctx)
+}
+
+// runAction runs the planned action on the replication consistency group, unless the group is already in the expected state,
+// and polls the group until it reaches that state or the context deadline is exceeded.
+func (r *replicationConsistencyGroupActionResource) runAction(ctx context.Context, plan *models.ReplicationConsistencyGroupActionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	id := plan.ReplicationConsistencyGroupID.ValueString()
+	action := plan.Action.ValueString()
+
+	pollInterval, err := time.ParseDuration(plan.PollInterval.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Invalid poll interval",
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	rcg, err := r.client.GetReplicationConsistencyGroupByID(id)
+	if err != nil {
+		diags.AddError(
+			"Error getting replication consistency group",
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	directionBefore := rcg.ReplicationDirection
+
+	// the failback always reverses the replication direction, so it can't be skipped
+	if action != helper.RcgActionFailback && helper.ReplicationConsistencyGroupActionDone(action, rcg, directionBefore) {
+		tflog.Info(ctx, "[POWERFLEX] replication consistency group "+id+" is already in the state expected after "+action)
+		helper.UpdateReplicationConsistencyGroupActionState(rcg, plan)
+		return diags
+	}
+
+	group := goscaleio.NewReplicationConsistencyGroup(r.client)
+	group.ReplicationConsistencyGroup = rcg
+	switch action {
+	case helper.RcgActionFailover:
+		err = group.ExecuteFailoverOnReplicationGroup()
+	case helper.RcgActionFailback:
+		err = group.ExecuteReverseOnReplicationGroup()
+	case helper.RcgActionRestore:
+		err = group.ExecuteRestoreOnReplicationGroup()
+	case helper.RcgActionSwitchover:
+		err = group.ExecuteSwitchoverOnReplicationGroup(false)
+	case helper.RcgActionFreeze:
+		err = group.FreezeReplicationConsistencyGroup(id)
+	case helper.RcgActionUnfreeze:
+		err = client.UnfreezeReplicationConsistencyGroup(ctx, r.client, id)
+	case helper.RcgActionPause:
+		err = group.ExecutePauseOnReplicationGroup()
+	case helper.RcgActionResume:
+		err = group.ExecuteResumeOnReplicationGroup()
+	}
+	if err != nil {
+		diags.AddError(
+			"Error running "+action+" on replication consistency group "+id,
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	tflog.Info(ctx, "[POWERFLEX] "+action+" started on replication consistency group "+id)
+
+	err = helper.WaitFor(ctx, pollInterval, func() (bool, error) {
+		rcg, err = r.client.GetReplicationConsistencyGroupByID(id)
+		if err != nil {
+			return false, err
+		}
+		return helper.ReplicationConsistencyGroupActionDone(action, rcg, directionBefore), nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Error waiting for "+action+" of replication consistency group "+id,
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	tflog.Info(ctx, "[POWERFLEX] "+action+" completed on replication consistency group "+id)
+
+	helper.UpdateReplicationConsistencyGroupActionState(rcg, plan)
+	return diags
+}
+
+// readAction refreshes the state of the replication consistency group in the plan.
+func (r *replicationConsistencyGroupActionResource) readAction(ctx context.Context, plan *models.ReplicationConsistencyGroupActionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	rcg, err := r.client.GetReplicationConsistencyGroupByID(plan.ReplicationConsistencyGroupID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error getting replication consistency group",
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	helper.UpdateReplicationConsistencyGroupActionState(rcg, plan)
+	return diags
+}
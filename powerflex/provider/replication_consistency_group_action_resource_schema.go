@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ReplicationConsistencyGroupActionResourceSchema variable to define schema for the replication consistency group action resource
+var ReplicationConsistencyGroupActionResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource can be used to run disaster recovery actions like failover, failback or switchover on an existing replication consistency group of a PowerFlex array." +
+		" The action is run when the resource is created and whenever the action is updated, the resource then waits until the group reaches the expected state." +
+		" Destroying the resource does not change the replication consistency group.",
+	MarkdownDescription: "This resource can be used to run disaster recovery actions like failover, failback or switchover on an existing replication consistency group of a PowerFlex array." +
+		" The action is run when the resource is created and whenever the `action` is updated, the resource then waits until the group reaches the expected state." +
+		" Destroying the resource does not change the replication consistency group.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the replication consistency group.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the replication consistency group.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"replication_consistency_group_id": schema.StringAttribute{
+			Description:         "The ID of the replication consistency group on which the action is run. Cannot be updated.",
+			Required:            true,
+			MarkdownDescription: "The ID of the replication consistency group on which the action is run. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"action": schema.StringAttribute{
+			Description: "The action to run on the replication consistency group." +
+				" Valid values are 'failover', 'failback', 'restore', 'switchover', 'freeze', 'unfreeze', 'pause' and 'resume'." +
+				" 'failback' reverses the replication direction of a failed over group while 'restore' keeps the original direction." +
+				" The action is run again whenever it is updated.",
+			Required: true,
+			MarkdownDescription: "The action to run on the replication consistency group." +
+				" Valid values are `failover`, `failback`, `restore`, `switchover`, `freeze`, `unfreeze`, `pause` and `resume`." +
+				" `failback` reverses the replication direction of a failed over group while `restore` keeps the original direction." +
+				" The action is run again whenever it is updated.",
+			Validators: []validator.String{stringvalidator.OneOf(
+				helper.RcgActionFailover,
+				helper.RcgActionFailback,
+				helper.RcgActionRestore,
+				helper.RcgActionSwitchover,
+				helper.RcgActionFreeze,
+				helper.RcgActionUnfreeze,
+				helper.RcgActionPause,
+				helper.RcgActionResume,
+			)},
+		},
+		"poll_interval": schema.StringAttribute{
+			Description: "The interval at which the replication consistency group is polled until it reaches the state expected after the action, like '5s' or '1m'." +
+				" The polling is bounded by the create and update timeouts. Default value is '10s'.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The interval at which the replication consistency group is polled until it reaches the state expected after the action, like `5s` or `1m`." +
+				" The polling is bounded by the create and update timeouts. Default value is `10s`.",
+			Validators: []validator.String{
+				helper.DurationValidator{},
+			},
+			PlanModifiers: []planmodifier.String{
+				helper.StringDefault(helper.DefaultRcgActionPollInterval),
+			},
+		},
+		"failover_type": schema.StringAttribute{
+			Description:         "The failover type of the replication consistency group, like 'None', 'Failover' or 'Switchover'.",
+			Computed:            true,
+			MarkdownDescription: "The failover type of the replication consistency group, like `None`, `Failover` or `Switchover`.",
+		},
+		"failover_state": schema.StringAttribute{
+			Description:         "The failover state of the replication consistency group.",
+			Computed:            true,
+			MarkdownDescription: "The failover state of the replication consistency group.",
+		},
+		"freeze_state": schema.StringAttribute{
+			Description:         "The freeze state of the replication consistency group, 'Frozen' or 'Unfrozen'.",
+			Computed:            true,
+			MarkdownDescription: "The freeze state of the replication consistency group, `Frozen` or `Unfrozen`.",
+		},
+		"pause_mode": schema.StringAttribute{
+			Description:         "The pause mode of the replication consistency group, 'None' when it is not paused.",
+			Computed:            true,
+			MarkdownDescription: "The pause mode of the replication consistency group, `None` when it is not paused.",
+		},
+		"replication_direction": schema.StringAttribute{
+			Description:         "The replication direction of the replication consistency group, 'LocalToRemote' or 'RemoteToLocal'.",
+			Computed:            true,
+			MarkdownDescription: "The replication direction of the replication consistency group, `LocalToRemote` or `RemoteToLocal`.",
+		},
+		"disaster_recovery_state": schema.StringAttribute{
+			Description:         "The disaster recovery state of the replication consistency group.",
+			Computed:            true,
+			MarkdownDescription: "The disaster recovery state of the replication consistency group.",
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"timeouts": TimeoutsBlock,
+	},
+}
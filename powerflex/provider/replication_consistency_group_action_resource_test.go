@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func replicationConsistencyGroupAction(action string) string {
+	return createReplicationPairPosTest + `
+resource "powerflex_replication_consistency_group_action" "dr" {
+	replication_consistency_group_id = powerflex_replication_consistency_group.rcg.id
+	action = "` + action + `"
+	poll_interval = "5s"
+	depends_on = [powerflex_replication_pair.pair]
+}
+`
+}
+
+var replicationConsistencyGroupActionInvalidPollIntervalTest = replicationPairRcg + `
+resource "powerflex_replication_consistency_group_action" "dr-invalid" {
+	replication_consistency_group_id = powerflex_replication_consistency_group.rcg.id
+	action = "freeze"
+	poll_interval = "often"
+}
+`
+
+func TestAccReplicationConsistencyGroupActionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + replicationConsistencyGroupAction("teleport"),
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+			{
+				Config:      ProviderConfigForTesting + replicationConsistencyGroupActionInvalidPollIntervalTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Duration*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + replicationConsistencyGroupAction("freeze"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.dr", "freeze_state", "Frozen"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.dr", "poll_interval", "5s"),
+					resource.TestCheckResourceAttrPair("powerflex_replication_consistency_group_action.dr", "id", "powerflex_replication_consistency_group.rcg", "id"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + replicationConsistencyGroupAction("unfreeze"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.dr", "freeze_state", "Unfrozen"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + replicationConsistencyGroupAction("failover"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.dr", "failover_type", "Failover"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.dr", "failover_state", "Done"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + replicationConsistencyGroupAction("restore"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.dr", "failover_type", "None"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.dr", "replication_direction", "LocalToRemote"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + replicationConsistencyGroupAction("pause"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.dr", "pause_mode", "StopDataTransfer"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + replicationConsistencyGroupAction("resume"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.dr", "pause_mode", "None"),
+				),
+			},
+		},
+	})
+}
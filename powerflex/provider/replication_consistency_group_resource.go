@@ -0,0 +1,264 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &replicationConsistencyGroupResource{}
+	_ resource.ResourceWithConfigure   = &replicationConsistencyGroupResource{}
+	_ resource.ResourceWithImportState = &replicationConsistencyGroupResource{}
+)
+
+// NewReplicationConsistencyGroupResource is a helper function to simplify the provider implementation.
+func NewReplicationConsistencyGroupResource() resource.Resource {
+	return &replicationConsistencyGroupResource{}
+}
+
+// replicationConsistencyGroupResource is the resource implementation.
+type replicationConsistencyGroupResource struct {
+	client *goscaleio.Client
+}
+
+// Metadata returns the resource type name.
+func (r *replicationConsistencyGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_consistency_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *replicationConsistencyGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ReplicationConsistencyGroupResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *replicationConsistencyGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *replicationConsistencyGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.ReplicationConsistencyGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rcgResp, err := r.client.CreateReplicationConsistencyGroup(&scaleiotypes.ReplicationConsistencyGroupCreatePayload{
+		Name:                     plan.Name.ValueString(),
+		RpoInSeconds:             strconv.FormatInt(plan.RpoInSeconds.ValueInt64(), 10),
+		ProtectionDomainID:       plan.ProtectionDomainID.ValueString(),
+		RemoteProtectionDomainID: plan.RemoteProtectionDomainID.ValueString(),
+		PeerMdmID:                plan.PeerSystemID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating replication consistency group",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id := rcgResp.ID
+	tflog.Info(ctx, "[POWERFLEX] replication consistency group "+id+" created")
+
+	// a new replication consistency group is active and not paused, the settings of the plan
+	// which differ are applied once it exists, a failure keeps the group in the state
+	state := plan
+	dgs := r.readReplicationConsistencyGroup(ctx, id, &state)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	errMsg := r.updateReplicationConsistencyGroup(ctx, id, plan, state)
+
+	dgs = r.readReplicationConsistencyGroup(ctx, id, &state)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	addFailureMessage(&resp.Diagnostics, errMsg)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *replicationConsistencyGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.ReplicationConsistencyGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rcg, err := r.client.GetReplicationConsistencyGroupByID(state.ID.ValueString())
+	// remove the replication consistency group from the state when it has been deleted outside of terraform
+	if helper.IsNotFoundError(err) {
+		tflog.Warn(ctx, "[POWERFLEX] replication consistency group "+state.ID.ValueString()+" not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting replication consistency group",
+			"Could not get replication consistency group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	helper.UpdateReplicationConsistencyGroupState(rcg, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *replicationConsistencyGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.ReplicationConsistencyGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var state models.ReplicationConsistencyGroupResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	errMsg := r.updateReplicationConsistencyGroup(ctx, id, plan, state)
+
+	newState := plan
+	dgs := r.readReplicationConsistencyGroup(ctx, id, &newState)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	addFailureMessage(&resp.Diagnostics, errMsg)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *replicationConsistencyGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.ReplicationConsistencyGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// goscaleio removes the replication consistency group through its self link
+	rcg, err := r.client.GetReplicationConsistencyGroupByID(state.ID.ValueString())
+	if err == nil {
+		group := goscaleio.NewReplicationConsistencyGroup(r.client)
+		group.ReplicationConsistencyGroup = rcg
+		err = group.RemoveReplicationConsistencyGroup(false)
+	}
+	if err != nil && !helper.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error removing replication consistency group",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the replication consistency group by its ID.
+func (r *replicationConsistencyGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateReplicationConsistencyGroup applies the differences between the plan and the state,
+// it returns the errors by attribute.
+func (r *replicationConsistencyGroupResource) updateReplicationConsistencyGroup(ctx context.Context, id string, plan, state models.ReplicationConsistencyGroupResourceModel) map[string]string {
+	errMsg := make(map[string]string, 0)
+
+	if plan.Name.ValueString() != state.Name.ValueString() {
+		if err := client.RenameReplicationConsistencyGroup(ctx, r.client, id, plan.Name.ValueString()); err != nil {
+			errMsg["name"] = err.Error()
+		}
+	}
+
+	if plan.RpoInSeconds.ValueInt64() != state.RpoInSeconds.ValueInt64() {
+		if err := client.ModifyReplicationConsistencyGroupRpo(ctx, r.client, id, int(plan.RpoInSeconds.ValueInt64())); err != nil {
+			errMsg["rpo_in_seconds"] = err.Error()
+		}
+	}
+
+	if plan.TargetVolumeAccessMode.ValueString() != state.TargetVolumeAccessMode.ValueString() {
+		err := client.ModifyReplicationConsistencyGroupTargetVolumeAccessMode(ctx, r.client, id, plan.TargetVolumeAccessMode.ValueString())
+		if err != nil {
+			errMsg["target_volume_access_mode"] = err.Error()
+		}
+	}
+
+	// the replication is activated before it is paused or resumed, and terminated after
+	if plan.Active.ValueBool() && !state.Active.ValueBool() {
+		if err := client.ActivateReplicationConsistencyGroup(ctx, r.client, id); err != nil {
+			errMsg["active"] = err.Error()
+		}
+	}
+
+	if plan.Paused.ValueBool() != state.Paused.ValueBool() {
+		group := goscaleio.NewReplicationConsistencyGroup(r.client)
+		group.ReplicationConsistencyGroup.ID = id
+		var err error
+		if plan.Paused.ValueBool() {
+			err = group.ExecutePauseOnReplicationGroup()
+		} else {
+			err = group.ExecuteResumeOnReplicationGroup()
+		}
+		if err != nil {
+			errMsg["paused"] = err.Error()
+		}
+	}
+
+	if !plan.Active.ValueBool() && state.Active.ValueBool() {
+		if err := client.TerminateReplicationConsistencyGroup(ctx, r.client, id); err != nil {
+			errMsg["active"] = err.Error()
+		}
+	}
+	return errMsg
+}
+
+// readReplicationConsistencyGroup refreshes the state from the replication consistency group
+func (r *replicationConsistencyGroupResource) readReplicationConsistencyGroup(ctx context.Context, id string, state *models.ReplicationConsistencyGroupResourceModel) (diags diag.Diagnostics) {
+	rcg, err := r.client.GetReplicationConsistencyGroupByID(id)
+	if err != nil {
+		diags.AddError(
+			"Error getting replication consistency group",
+			"Could not get replication consistency group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	helper.UpdateReplicationConsistencyGroupState(rcg, state)
+	return
+}
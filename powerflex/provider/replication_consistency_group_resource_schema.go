@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ReplicationConsistencyGroupResourceSchema variable to define schema for the replication consistency group resource
+var ReplicationConsistencyGroupResourceSchema schema.Schema = schema.Schema{
+	Description:         "This resource can be used to manage replication consistency groups on a PowerFlex array.",
+	MarkdownDescription: "This resource can be used to manage replication consistency groups on a PowerFlex array.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the replication consistency group.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the replication consistency group.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the replication consistency group.",
+			Required:            true,
+			MarkdownDescription: "The name of the replication consistency group.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"peer_system_id": schema.StringAttribute{
+			Description:         "The ID of the peer system the volumes are replicated to. Cannot be updated.",
+			Required:            true,
+			MarkdownDescription: "The ID of the peer system the volumes are replicated to, as returned by `powerflex_peer_system`. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"protection_domain_id": schema.StringAttribute{
+			Description:         "The ID of the protection domain of the source volumes. Cannot be updated.",
+			Required:            true,
+			MarkdownDescription: "The ID of the protection domain of the source volumes. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"remote_protection_domain_id": schema.StringAttribute{
+			Description:         "The ID of the protection domain of the target volumes on the peer system. Cannot be updated.",
+			Required:            true,
+			MarkdownDescription: "The ID of the protection domain of the target volumes on the peer system. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"rpo_in_seconds": schema.Int64Attribute{
+			Description:         "The recovery point objective of the replication, between 15 and 3600 seconds.",
+			Required:            true,
+			MarkdownDescription: "The recovery point objective of the replication, between 15 and 3600 seconds.",
+			Validators: []validator.Int64{
+				int64validator.Between(15, 3600),
+			},
+		},
+		"target_volume_access_mode": schema.StringAttribute{
+			Description:         "The access mode of the target volumes. Valid values are 'NoAccess' and 'ReadOnly'. Default value is 'NoAccess'.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The access mode of the target volumes. Valid values are `NoAccess` and `ReadOnly`. Default value is `NoAccess`.",
+			Validators: []validator.String{stringvalidator.OneOf(
+				"NoAccess",
+				"ReadOnly",
+			)},
+			PlanModifiers: []planmodifier.String{
+				helper.StringDefault("NoAccess"),
+			},
+		},
+		"active": schema.BoolAttribute{
+			Description: "Whether the replication is active, the replication is terminated when set to 'false'." +
+				" Default value is 'true'.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "Whether the replication is active, the replication is terminated when set to `false`." +
+				" Default value is `true`.",
+			PlanModifiers: []planmodifier.Bool{
+				helper.BoolDefault(true),
+			},
+		},
+		"paused": schema.BoolAttribute{
+			Description: "Whether the data transfer to the peer system is paused, only an active replication can be paused." +
+				" Default value is 'false'.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "Whether the data transfer to the peer system is paused, only an active replication can be paused." +
+				" Default value is `false`.",
+			PlanModifiers: []planmodifier.Bool{
+				helper.BoolDefault(false),
+			},
+		},
+		"destination_system_id": schema.StringAttribute{
+			Description:         "The ID of the remote PowerFlex system.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the remote PowerFlex system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"remote_id": schema.StringAttribute{
+			Description:         "The ID of the replication consistency group on the peer system.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the replication consistency group on the peer system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"replication_direction": schema.StringAttribute{
+			Description:         "The direction of the replication.",
+			Computed:            true,
+			MarkdownDescription: "The direction of the replication.",
+		},
+		"curr_consist_mode": schema.StringAttribute{
+			Description:         "The current consistency mode of the replication consistency group.",
+			Computed:            true,
+			MarkdownDescription: "The current consistency mode of the replication consistency group.",
+		},
+	},
+}
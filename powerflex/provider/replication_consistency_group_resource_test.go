@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var replicationPeerSystem = `
+resource "powerflex_peer_system" "peer" {
+	name = "tfacc-rcg-peer-system"
+	remote_system_id = "` + replicationRemoteSystemID + `"
+	ip_list = ["` + replicationRemoteMdmIP + `"]
+}
+`
+
+var createRcgPosTest = replicationPeerSystem + `
+resource "powerflex_replication_consistency_group" "rcg" {
+	name = "tfacc-rcg"
+	peer_system_id = powerflex_peer_system.peer.id
+	protection_domain_id = "` + protectionDomainID1 + `"
+	remote_protection_domain_id = "` + replicationRemoteProtectionDomainID + `"
+	rpo_in_seconds = 60
+}
+`
+
+var updateRcgPosTest = replicationPeerSystem + `
+resource "powerflex_replication_consistency_group" "rcg" {
+	name = "tfacc-rcg-1"
+	peer_system_id = powerflex_peer_system.peer.id
+	protection_domain_id = "` + protectionDomainID1 + `"
+	remote_protection_domain_id = "` + replicationRemoteProtectionDomainID + `"
+	rpo_in_seconds = 120
+	target_volume_access_mode = "ReadOnly"
+	paused = true
+}
+`
+
+var terminateRcgPosTest = strings.Replace(createRcgPosTest, "rpo_in_seconds = 60", "rpo_in_seconds = 60\n\tactive = false", 1)
+
+var createRcgInvalidRpoTest = replicationPeerSystem + `
+resource "powerflex_replication_consistency_group" "rcg-invalid" {
+	name = "tfacc-rcg-invalid"
+	peer_system_id = powerflex_peer_system.peer.id
+	protection_domain_id = "` + protectionDomainID1 + `"
+	remote_protection_domain_id = "` + replicationRemoteProtectionDomainID + `"
+	rpo_in_seconds = 5
+}
+`
+
+func TestAccReplicationConsistencyGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createRcgInvalidRpoTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createRcgPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "name", "tfacc-rcg"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "rpo_in_seconds", "60"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "protection_domain_id", protectionDomainID1),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "remote_protection_domain_id", replicationRemoteProtectionDomainID),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "destination_system_id", replicationRemoteSystemID),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "target_volume_access_mode", "NoAccess"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "active", "true"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "paused", "false"),
+					resource.TestCheckResourceAttrPair("powerflex_replication_consistency_group.rcg", "peer_system_id", "powerflex_peer_system.peer", "id"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_replication_consistency_group.rcg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + updateRcgPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "name", "tfacc-rcg-1"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "rpo_in_seconds", "120"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "target_volume_access_mode", "ReadOnly"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "paused", "true"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + terminateRcgPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "name", "tfacc-rcg"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "active", "false"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group.rcg", "paused", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,262 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &replicationPairResource{}
+	_ resource.ResourceWithConfigure   = &replicationPairResource{}
+	_ resource.ResourceWithImportState = &replicationPairResource{}
+)
+
+// NewReplicationPairResource is a helper function to simplify the provider implementation.
+func NewReplicationPairResource() resource.Resource {
+	return &replicationPairResource{}
+}
+
+// replicationPairResource is the resource implementation.
+type replicationPairResource struct {
+	client *goscaleio.Client
+}
+
+// Metadata returns the resource type name.
+func (r *replicationPairResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_pair"
+}
+
+// Schema defines the schema for the resource.
+func (r *replicationPairResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ReplicationPairResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *replicationPairResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *replicationPairResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.ReplicationPairResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceVolume, dgs := r.getSourceVolume(plan)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.SourceVolumeName = types.StringValue(sourceVolume.Name)
+
+	pair, err := r.client.CreateReplicationPair(&scaleiotypes.QueryReplicationPair{
+		Name:                          plan.Name.ValueString(),
+		SourceVolumeID:                sourceVolume.ID,
+		DestinationVolumeID:           plan.TargetVolumeID.ValueString(),
+		ReplicationConsistencyGroupID: plan.ReplicationConsistencyGroupID.ValueString(),
+		CopyType:                      plan.CopyType.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating replication pair",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id := pair.ID
+	tflog.Info(ctx, "[POWERFLEX] replication pair "+id+" created")
+
+	dgs = r.readReplicationPair(ctx, id, &plan)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *replicationPairResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.ReplicationPairResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pair, err := helper.GetReplicationPair(r.client, state.ID.ValueString())
+	// remove the replication pair from the state when it has been deleted outside of terraform
+	if helper.IsNotFoundError(err) {
+		tflog.Warn(ctx, "[POWERFLEX] replication pair "+state.ID.ValueString()+" not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting replication pair",
+			"Could not get replication pair, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	dgs := r.updateReplicationPairState(pair, &state)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the name of the source volume is not known when the replication pair is imported
+	if state.SourceVolumeName.IsNull() {
+		sourceVolume, dgs := r.getSourceVolume(state)
+		resp.Diagnostics.Append(dgs...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.SourceVolumeName = types.StringValue(sourceVolume.Name)
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update refreshes the state, all the attributes of a replication pair require a replacement.
+func (r *replicationPairResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.ReplicationPairResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dgs := r.readReplicationPair(ctx, plan.ID.ValueString(), &plan)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success, the volumes of the pair are kept.
+func (r *replicationPairResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.ReplicationPairResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pair := goscaleio.NewReplicationPair(r.client)
+	pair.ReplicaitonPair.ID = state.ID.ValueString()
+	_, err := pair.RemoveReplicationPair(false)
+	if err != nil && !helper.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error removing replication pair",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the replication pair by its ID.
+func (r *replicationPairResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getSourceVolume returns the source volume of the replication pair by its ID, or by its name when the ID is not known
+func (r *replicationPairResource) getSourceVolume(plan models.ReplicationPairResourceModel) (*scaleiotypes.Volume, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !plan.SourceVolumeID.IsUnknown() && !plan.SourceVolumeID.IsNull() {
+		vols, err := r.client.GetVolume("", plan.SourceVolumeID.ValueString(), "", "", false)
+		if err != nil {
+			diags.AddError(
+				"Error getting source volume by id",
+				"unexpected error: "+err.Error(),
+			)
+			return nil, diags
+		}
+		return vols[0], diags
+	}
+	vols, err := r.client.GetVolume("", "", "", plan.SourceVolumeName.ValueString(), false)
+	if err != nil {
+		diags.AddError(
+			"Error getting source volume by name",
+			"unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+	if len(vols) == 0 {
+		diags.AddError(
+			"Error getting source volume by name",
+			"volume with name "+plan.SourceVolumeName.ValueString()+" not found",
+		)
+		return nil, diags
+	}
+	return vols[0], diags
+}
+
+// readReplicationPair refreshes the state from the replication pair and its statistics
+func (r *replicationPairResource) readReplicationPair(ctx context.Context, id string, state *models.ReplicationPairResourceModel) (diags diag.Diagnostics) {
+	pair, err := helper.GetReplicationPair(r.client, id)
+	if err != nil {
+		diags.AddError(
+			"Error getting replication pair",
+			"Could not get replication pair, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	return r.updateReplicationPairState(pair, state)
+}
+
+// updateReplicationPairState saves the replication pair and its statistics in the state
+func (r *replicationPairResource) updateReplicationPairState(pair *scaleiotypes.ReplicationPair, state *models.ReplicationPairResourceModel) (diags diag.Diagnostics) {
+	rp := goscaleio.NewReplicationPair(r.client)
+	rp.ReplicaitonPair = pair
+	stats, err := rp.GetReplicationPairStatistics()
+	if err != nil {
+		diags.AddError(
+			"Error getting the statistics of the replication pair",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	helper.UpdateReplicationPairState(pair, stats, state)
+	return
+}
@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ReplicationPairResourceSchema variable to define schema for the replication pair resource
+var ReplicationPairResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource can be used to manage the replication pairs of a replication consistency group on a PowerFlex array." +
+		" Destroying a replication pair keeps its source and target volumes.",
+	MarkdownDescription: "This resource can be used to manage the replication pairs of a replication consistency group on a PowerFlex array." +
+		" Destroying a replication pair keeps its source and target volumes.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the replication pair.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the replication pair.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the replication pair. Cannot be updated.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The name of the replication pair. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"replication_consistency_group_id": schema.StringAttribute{
+			Description:         "The ID of the replication consistency group of the pair. Cannot be updated.",
+			Required:            true,
+			MarkdownDescription: "The ID of the replication consistency group of the pair. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"source_volume_id": schema.StringAttribute{
+			Description: "The ID of the source volume, in the protection domain of the replication consistency group." +
+				" Conflicts with 'source_volume_name'." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The ID of the source volume, in the protection domain of the replication consistency group." +
+				" Conflicts with `source_volume_name`." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("source_volume_name")),
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"source_volume_name": schema.StringAttribute{
+			Description: "The name of the source volume, in the protection domain of the replication consistency group." +
+				" Conflicts with 'source_volume_id'." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The name of the source volume, in the protection domain of the replication consistency group." +
+				" Conflicts with `source_volume_id`." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"target_volume_id": schema.StringAttribute{
+			Description: "The ID of the target volume on the peer system, it must be at least as large as the source volume." +
+				" The target volume is not visible to the local system, so it cannot be looked up by name." +
+				" Cannot be updated.",
+			Required: true,
+			MarkdownDescription: "The ID of the target volume on the peer system, it must be at least as large as the source volume." +
+				" The target volume is not visible to the local system, so it cannot be looked up by name." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"copy_type": schema.StringAttribute{
+			Description: "How the initial copy of the source volume is made. Valid values are 'OnlineCopy', 'OnlineHashCopy' and 'OfflineCopy'." +
+				" Default value is 'OnlineCopy'." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "How the initial copy of the source volume is made. Valid values are `OnlineCopy`, `OnlineHashCopy` and `OfflineCopy`." +
+				" Default value is `OnlineCopy`." +
+				" Cannot be updated.",
+			Validators: []validator.String{stringvalidator.OneOf(
+				"OnlineCopy",
+				"OnlineHashCopy",
+				"OfflineCopy",
+			)},
+			PlanModifiers: []planmodifier.String{
+				helper.StringDefault("OnlineCopy"),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"target_volume_name": schema.StringAttribute{
+			Description:         "The name of the target volume on the peer system.",
+			Computed:            true,
+			MarkdownDescription: "The name of the target volume on the peer system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"remote_id": schema.StringAttribute{
+			Description:         "The ID of the replication pair on the peer system.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the replication pair on the peer system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"peer_system_name": schema.StringAttribute{
+			Description:         "The name of the peer system of the replication pair.",
+			Computed:            true,
+			MarkdownDescription: "The name of the peer system of the replication pair.",
+		},
+		"lifetime_state": schema.StringAttribute{
+			Description:         "The lifetime state of the replication pair.",
+			Computed:            true,
+			MarkdownDescription: "The lifetime state of the replication pair.",
+		},
+		"initial_copy_state": schema.StringAttribute{
+			Description:         "The state of the initial copy of the source volume to the target volume.",
+			Computed:            true,
+			MarkdownDescription: "The state of the initial copy of the source volume to the target volume.",
+		},
+		"initial_copy_progress": schema.Float64Attribute{
+			Description:         "The progress of the initial copy of the source volume to the target volume.",
+			Computed:            true,
+			MarkdownDescription: "The progress of the initial copy of the source volume to the target volume.",
+		},
+	},
+}
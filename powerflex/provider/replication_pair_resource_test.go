@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// the target volume lives on the remote system, it must be at least as large as the source volume
+var replicationRemoteVolumeID = os.Getenv("POWERFLEX_REPLICATION_REMOTE_VOLUME_ID")
+
+var replicationPairRcg = replicationPeerSystem + `
+data "powerflex_volume" "source" {
+	name = "` + SdsResourceTestData.volName + `"
+}
+
+resource "powerflex_replication_consistency_group" "rcg" {
+	name = "tfacc-pair-rcg"
+	peer_system_id = powerflex_peer_system.peer.id
+	protection_domain_id = "` + protectionDomainID1 + `"
+	remote_protection_domain_id = "` + replicationRemoteProtectionDomainID + `"
+	rpo_in_seconds = 60
+}
+`
+
+var createReplicationPairPosTest = replicationPairRcg + `
+resource "powerflex_replication_pair" "pair" {
+	name = "tfacc-pair"
+	replication_consistency_group_id = powerflex_replication_consistency_group.rcg.id
+	source_volume_name = "` + SdsResourceTestData.volName + `"
+	target_volume_id = "` + replicationRemoteVolumeID + `"
+}
+`
+
+var sourceIDReplicationPairPosTest = replicationPairRcg + `
+resource "powerflex_replication_pair" "pair" {
+	name = "tfacc-pair"
+	replication_consistency_group_id = powerflex_replication_consistency_group.rcg.id
+	source_volume_id = data.powerflex_volume.source.volumes[0].id
+	target_volume_id = "` + replicationRemoteVolumeID + `"
+}
+`
+
+var createReplicationPairConflictTest = replicationPairRcg + `
+resource "powerflex_replication_pair" "pair-invalid" {
+	replication_consistency_group_id = powerflex_replication_consistency_group.rcg.id
+	source_volume_name = "` + SdsResourceTestData.volName + `"
+	source_volume_id = data.powerflex_volume.source.volumes[0].id
+	target_volume_id = "` + replicationRemoteVolumeID + `"
+}
+`
+
+var createReplicationPairInvalidVolumeTest = replicationPairRcg + `
+resource "powerflex_replication_pair" "pair-invalid" {
+	replication_consistency_group_id = powerflex_replication_consistency_group.rcg.id
+	source_volume_name = "tfacc-invalid-volume"
+	target_volume_id = "` + replicationRemoteVolumeID + `"
+}
+`
+
+func TestAccReplicationPairResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createReplicationPairConflictTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			{
+				Config:      ProviderConfigForTesting + createReplicationPairInvalidVolumeTest,
+				ExpectError: regexp.MustCompile(`.*Error getting source volume by name*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createReplicationPairPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_pair.pair", "name", "tfacc-pair"),
+					resource.TestCheckResourceAttr("powerflex_replication_pair.pair", "source_volume_name", SdsResourceTestData.volName),
+					resource.TestCheckResourceAttr("powerflex_replication_pair.pair", "target_volume_id", replicationRemoteVolumeID),
+					resource.TestCheckResourceAttr("powerflex_replication_pair.pair", "copy_type", "OnlineCopy"),
+					resource.TestCheckResourceAttrPair("powerflex_replication_pair.pair", "replication_consistency_group_id", "powerflex_replication_consistency_group.rcg", "id"),
+					resource.TestCheckResourceAttrPair("powerflex_replication_pair.pair", "source_volume_id", "data.powerflex_volume.source", "volumes.0.id"),
+					resource.TestCheckResourceAttrSet("powerflex_replication_pair.pair", "initial_copy_state"),
+					resource.TestCheckResourceAttrSet("powerflex_replication_pair.pair", "initial_copy_progress"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_replication_pair.pair",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// referring to the same source volume by its ID keeps the pair
+			{
+				Config: ProviderConfigForTesting + sourceIDReplicationPairPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("powerflex_replication_pair.pair", "source_volume_id", "data.powerflex_volume.source", "volumes.0.id"),
+					resource.TestCheckResourceAttr("powerflex_replication_pair.pair", "source_volume_name", SdsResourceTestData.volName),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,259 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sdcApprovalResource{}
+	_ resource.ResourceWithConfigure   = &sdcApprovalResource{}
+	_ resource.ResourceWithImportState = &sdcApprovalResource{}
+)
+
+// NewSdcApprovalResource is a helper function to simplify the provider implementation.
+func NewSdcApprovalResource() resource.Resource {
+	return &sdcApprovalResource{}
+}
+
+// sdcApprovalResource is the resource implementation.
+type sdcApprovalResource struct {
+	client   *goscaleio.Client
+	systemID string
+}
+
+// Metadata returns the resource type name.
+func (r *sdcApprovalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sdc_approval"
+}
+
+// Schema defines the schema for the resource.
+func (r *sdcApprovalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = SdcApprovalResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sdcApprovalResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
+}
+
+// Create approves the SDC and sets the initial Terraform state.
+// An SDC which is already approved must be imported, so that destroying the resource does not remove it.
+func (r *sdcApprovalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SdcApprovalResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	system, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	field, value := "SdcGUID", plan.SdcGUID.ValueString()
+	if plan.SdcGUID.IsNull() {
+		field, value = "SdcIP", plan.SdcIP.ValueString()
+	}
+	if sdc, err := system.FindSdc(field, value); err == nil && sdc.Sdc.SdcApproved {
+		resp.Diagnostics.AddError(
+			"SDC already approved",
+			"The SDC "+sdc.Sdc.ID+" is already approved, import it with terraform import to manage its approval.",
+		)
+		return
+	}
+	id, err := client.ApproveSdc(ctx, r.client, system.System.ID, &client.ApproveSdcParam{
+		SdcGUID: plan.SdcGUID.ValueString(),
+		SdcIP:   plan.SdcIP.ValueString(),
+		Name:    plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error approving SDC",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "[POWERFLEX] SDC "+id+" approved")
+
+	sdc, err := system.GetSdcByID(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SDC after approval",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	helper.UpdateSdcApprovalState(sdc.Sdc, "", &plan)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sdcApprovalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SdcApprovalResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	system, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	sdc, err := system.GetSdcByID(state.ID.ValueString())
+	if err != nil {
+		// remove the SDC from the state when it has been removed outside of terraform
+		if helper.IsNotFoundError(err) {
+			tflog.Warn(ctx, "[POWERFLEX] SDC "+state.ID.ValueString()+" not found, removing it from the state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error getting SDC",
+			"Could not get SDC, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// the SDC is identified by its GUID or by its IP depending on the restricted SDC mode after an import
+	var restrictedSdcMode string
+	if state.SdcGUID.IsNull() && state.SdcIP.IsNull() {
+		settings, err := client.GetSystemSettings(ctx, r.client, system.System.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting restricted SDC mode of the system",
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+		restrictedSdcMode = settings.RestrictedSdcMode
+	}
+
+	helper.UpdateSdcApprovalState(sdc.Sdc, restrictedSdcMode, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Only the name of the SDC can be updated, the other attributes require a replacement.
+func (r *sdcApprovalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.SdcApprovalResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var state models.SdcApprovalResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	system, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.Name.IsUnknown() && plan.Name.ValueString() != state.Name.ValueString() {
+		if _, err := system.ChangeSdcName(state.ID.ValueString(), plan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error renaming SDC",
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	sdc, err := system.GetSdcByID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SDC after update",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	helper.UpdateSdcApprovalState(sdc.Sdc, "", &plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the SDC, which revokes its approval, and removes the Terraform state on success.
+func (r *sdcApprovalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.SdcApprovalResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	system, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	err = system.DeleteSdc(state.ID.ValueString())
+	if err != nil && !helper.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error removing SDC",
+			"Couldn't remove SDC, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the SDC by its ID.
+func (r *sdcApprovalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SdcApprovalResourceSchema variable to define schema for the SDC approval resource
+var SdcApprovalResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource can be used to approve SDCs on a PowerFlex array running in a restricted SDC mode." +
+		" SDCs which are not connected yet are pre-approved, so that new hosts can connect.",
+	MarkdownDescription: "This resource can be used to approve SDCs on a PowerFlex array running in a restricted SDC mode." +
+		" SDCs which are not connected yet are pre-approved, so that new hosts can connect.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the SDC.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the SDC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sdc_guid": schema.StringAttribute{
+			Description: "GUID of the SDC to approve, in the 'Guid' restricted SDC mode." +
+				" Conflicts with 'sdc_ip'." +
+				" Cannot be updated.",
+			Optional: true,
+			MarkdownDescription: "GUID of the SDC to approve, in the `Guid` restricted SDC mode." +
+				" Conflicts with `sdc_ip`." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("sdc_ip")),
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"sdc_ip": schema.StringAttribute{
+			Description: "IP of the SDC to approve, in the 'ApprovedIp' restricted SDC mode." +
+				" Conflicts with 'sdc_guid'." +
+				" Cannot be updated.",
+			Optional: true,
+			MarkdownDescription: "IP of the SDC to approve, in the `ApprovedIp` restricted SDC mode." +
+				" Conflicts with `sdc_guid`." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the SDC, set when it is approved.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The name of the SDC, set when it is approved.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sdc_approved": schema.BoolAttribute{
+			Description:         "Whether the SDC is approved.",
+			Computed:            true,
+			MarkdownDescription: "Whether the SDC is approved.",
+		},
+		"mdm_connection_state": schema.StringAttribute{
+			Description:         "The state of the connection of the SDC to the MDM, 'Disconnected' until a pre-approved SDC connects.",
+			Computed:            true,
+			MarkdownDescription: "The state of the connection of the SDC to the MDM, `Disconnected` until a pre-approved SDC connects.",
+		},
+	},
+}
@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var sdcApprovalGUID = os.Getenv("POWERFLEX_SDC_APPROVAL_GUID")
+
+var sdcApprovalGUIDModeTest = `
+resource "powerflex_system" "system" {
+	restricted_sdc_mode = "Guid"
+}
+`
+
+var sdcApprovalNoModeTest = `
+resource "powerflex_system" "system" {
+	restricted_sdc_mode = "None"
+}
+`
+
+var createSdcApprovalPosTest = sdcApprovalGUIDModeTest + `
+resource "powerflex_sdc_approval" "sdc" {
+	depends_on = [powerflex_system.system]
+	sdc_guid = "` + sdcApprovalGUID + `"
+	name = "tfacc_sdc_host"
+}
+`
+
+var updateSdcApprovalPosTest = sdcApprovalGUIDModeTest + `
+resource "powerflex_sdc_approval" "sdc" {
+	depends_on = [powerflex_system.system]
+	sdc_guid = "` + sdcApprovalGUID + `"
+	name = "tfacc_sdc_host_1"
+}
+`
+
+var createSdcApprovalWrongModeTest = sdcApprovalGUIDModeTest + `
+resource "powerflex_sdc_approval" "sdc-invalid" {
+	depends_on = [powerflex_system.system]
+	sdc_ip = "192.0.2.250"
+}
+`
+
+var createSdcApprovalApprovedTest = updateSdcApprovalPosTest + `
+resource "powerflex_sdc_approval" "sdc-approved" {
+	depends_on = [powerflex_sdc_approval.sdc]
+	sdc_guid = "` + sdcApprovalGUID + `"
+}
+`
+
+var createSdcApprovalConflictTest = `
+resource "powerflex_sdc_approval" "sdc-invalid" {
+	sdc_guid = "` + sdcApprovalGUID + `"
+	sdc_ip = "192.0.2.250"
+}
+`
+
+func TestAccSdcApprovalResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createSdcApprovalConflictTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			{
+				Config:      ProviderConfigForTesting + createSdcApprovalWrongModeTest,
+				ExpectError: regexp.MustCompile(`.*Error approving SDC*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createSdcApprovalPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_sdc_approval.sdc", "sdc_guid", sdcApprovalGUID),
+					resource.TestCheckResourceAttr("powerflex_sdc_approval.sdc", "name", "tfacc_sdc_host"),
+					resource.TestCheckResourceAttr("powerflex_sdc_approval.sdc", "sdc_approved", "true"),
+					resource.TestCheckResourceAttrSet("powerflex_sdc_approval.sdc", "id"),
+					resource.TestCheckResourceAttrSet("powerflex_sdc_approval.sdc", "mdm_connection_state"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_sdc_approval.sdc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + updateSdcApprovalPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_sdc_approval.sdc", "name", "tfacc_sdc_host_1"),
+				),
+			},
+			// an SDC which is already approved must be imported
+			{
+				Config:      ProviderConfigForTesting + createSdcApprovalApprovedTest,
+				ExpectError: regexp.MustCompile(`.*SDC already approved*.`),
+			},
+			// remove the approval, then restore the restricted SDC mode of the system
+			{
+				Config: ProviderConfigForTesting + sdcApprovalGUIDModeTest,
+			},
+			{
+				Config: ProviderConfigForTesting + sdcApprovalNoModeTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_system.system", "restricted_sdc_mode", "None"),
+				),
+			},
+		},
+	})
+}
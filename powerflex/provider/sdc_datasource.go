@@ -36,7 +36,8 @@ var (
 
 // sdcDataSource - for returning singleton holder with goscaleio client.
 type sdcDataSource struct {
-	client *goscaleio.Client
+	client   *goscaleio.Client
+	systemID string
 }
 
 // SDCDataSource - function used to return SDC DataSource provider with singleton values.
@@ -60,7 +61,9 @@ func (d *sdcDataSource) Configure(_ context.Context, req datasource.ConfigureReq
 		return
 	}
 
-	d.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	d.client = p.client
+	d.systemID = p.systemID
 }
 
 // Read - function to read sdc values from goscaleio.
@@ -69,7 +72,7 @@ func (d *sdcDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	diags := req.Config.Get(ctx, &state)
 	tflog.Info(ctx, "[POWERFLEX] sdcDataSourceModel"+helper.PrettyJSON((state)))
 
-	system, err := helper.GetFirstSystem(d.client)
+	system, err := helper.GetSystem(d.client, d.systemID, "")
 
 	if err != nil {
 		resp.Diagnostics.AddError(
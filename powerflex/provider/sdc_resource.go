@@ -48,6 +48,7 @@ func SDCResource() resource.Resource {
 // sdcResource - struct to define sdc resource
 type sdcResource struct {
 	client        *goscaleio.Client
+	systemID      string
 	gatewayClient *goscaleio.GatewayClient
 }
 
@@ -62,26 +63,16 @@ func (r *sdcResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 }
 
 // Configure - function to return Configuration for SDC resource.
-func (r *sdcResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+func (r *sdcResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
 
-	// Create a new PowerFlex gateway client using the configuration values
-	gatewayClient, err := goscaleio.NewGateway(r.client.GetConfigConnect().Endpoint, r.client.GetConfigConnect().Username, r.client.GetConfigConnect().Password, r.client.GetConfigConnect().Insecure, true)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Create gateway API Client",
-			"An unexpected error occurred when creating the gateway API client. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
-				"gateway Client Error: "+err.Error(),
-		)
-		return
-	}
-
-	r.gatewayClient = gatewayClient
+	r.gatewayClient = p.gatewayClient
 }
 
 // Create - function to Create for SDC resource.
@@ -97,11 +88,20 @@ func (r *sdcResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	// bound the operation by the create timeout of the resource
+	createTimeout, diags := helper.GetCreateTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	sdcDetailList := []models.SDCDetailDataModel{}
 	diags = plan.SDCDetails.ElementsAs(ctx, &sdcDetailList, true)
 	resp.Diagnostics.Append(diags...)
 
-	system, err := helper.GetFirstSystem(r.client)
+	system, err := helper.GetSystem(r.client, r.systemID, "")
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -181,7 +181,7 @@ func (r *sdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	diags = state.SDCDetails.ElementsAs(ctx, &sdcDetailList, true)
 	resp.Diagnostics.Append(diags...)
 
-	system, err := helper.GetFirstSystem(r.client)
+	system, err := helper.GetSystem(r.client, r.systemID, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error in getting system instance on the PowerFlex cluster",
@@ -217,6 +217,13 @@ func (r *sdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		//For handling the single SDC reanme operation
 		singleSdc, err := system.FindSdc("ID", state.ID.ValueString())
 
+		// remove the SDC from the state when it has been removed outside of terraform
+		if helper.IsNotFoundError(err) {
+			tflog.Warn(ctx, "[POWERFLEX] SDC "+state.ID.ValueString()+" not found, removing it from the state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Powerflex systems-sdcs Read",
@@ -240,7 +247,9 @@ func (r *sdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 				if sdc.SDCID.ValueString() != "" {
 					sdcData, err = system.GetSdcByID(sdc.SDCID.ValueString())
 
-					if err != nil {
+					if helper.IsNotFoundError(err) {
+						tflog.Warn(ctx, "[POWERFLEX] SDC not found, removing it from the state: "+err.Error())
+					} else if err != nil {
 						resp.Diagnostics.AddError(
 							"[Read] Unable to Find SDC by ID:"+sdc.SDCID.ValueString(),
 							err.Error(),
@@ -249,7 +258,9 @@ func (r *sdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 				} else if sdc.IP.ValueString() != "" {
 					sdcData, err = system.FindSdc("SdcIP", sdc.IP.ValueString())
 
-					if err != nil {
+					if helper.IsNotFoundError(err) {
+						tflog.Warn(ctx, "[POWERFLEX] SDC not found, removing it from the state: "+err.Error())
+					} else if err != nil {
 						resp.Diagnostics.AddError(
 							"[Read] Unable to Find SDC by IP:"+sdc.IP.ValueString(),
 							err.Error(),
@@ -258,7 +269,9 @@ func (r *sdcResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 				} else if sdc.SDCName.ValueString() != "" {
 					sdcData, err = system.FindSdc("Name", sdc.SDCName.ValueString())
 
-					if err != nil {
+					if helper.IsNotFoundError(err) {
+						tflog.Warn(ctx, "[POWERFLEX] SDC not found, removing it from the state: "+err.Error())
+					} else if err != nil {
 						resp.Diagnostics.AddError(
 							"[Read] Unable to Find SDC by Name:"+sdc.SDCName.ValueString(),
 							err.Error(),
@@ -311,7 +324,16 @@ func (r *sdcResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	system, err := helper.GetFirstSystem(r.client)
+	// bound the operation by the update timeout of the resource
+	updateTimeout, diags := helper.GetUpdateTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	system, err := helper.GetSystem(r.client, r.systemID, "")
 
 	planSdcDetailList := []models.SDCDetailDataModel{}
 	diags = plan.SDCDetails.ElementsAs(ctx, &planSdcDetailList, true)
@@ -448,11 +470,20 @@ func (r *sdcResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	// bound the operation by the delete timeout of the resource
+	deleteTimeout, diags := helper.GetDeleteTimeout(state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	sdcDetailList := []models.SDCDetailDataModel{}
 	diags = state.SDCDetails.ElementsAs(ctx, &sdcDetailList, true)
 	resp.Diagnostics.Append(diags...)
 
-	system, err := helper.GetFirstSystem(r.client)
+	system, err := helper.GetSystem(r.client, r.systemID, "")
 
 	if err != nil {
 		resp.Diagnostics.AddError(
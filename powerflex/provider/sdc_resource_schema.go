@@ -96,6 +96,9 @@ var SDCReourceSchema schema.Schema = schema.Schema{
 			},
 		},
 	},
+	Blocks: map[string]schema.Block{
+		"timeouts": TimeoutsBlock,
+	},
 }
 
 // sdcDetailSchema - variable holds schema for CSV Param Details
@@ -36,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // NewSDCVolumesMappingResource is a helper function to simplify the provider implementation.
@@ -45,7 +46,8 @@ func NewSDCVolumesMappingResource() resource.Resource {
 
 // sdsVolumeMappingResource is the resource implementation.
 type sdcVolumeMappingResource struct {
-	client *goscaleio.Client
+	client   *goscaleio.Client
+	systemID string
 }
 
 func (r *sdcVolumeMappingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -147,7 +149,9 @@ func (r *sdcVolumeMappingResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	r.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
 }
 
 // ModifyPlan modify resource plan attribute value
@@ -160,7 +164,7 @@ func (r *sdcVolumeMappingResource) ModifyPlan(ctx context.Context, req resource.
 	resp.Diagnostics.Append(diags...)
 
 	// Get the system on the PowerFlex cluster
-	system, err := helper.GetFirstSystem(r.client)
+	system, err := helper.GetSystem(r.client, r.systemID, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error in getting system instance on the PowerFlex cluster",
@@ -262,7 +266,7 @@ func (r *sdcVolumeMappingResource) Create(ctx context.Context, req resource.Crea
 		}
 	}
 
-	sdcType, err1 := helper.GetSdcType(r.client, plan.ID.ValueString())
+	sdcType, err1 := helper.GetSdcType(r.client, r.systemID, plan.ID.ValueString())
 	if err1 != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting SDC type: "+plan.ID.String(),
@@ -298,7 +302,22 @@ func (r *sdcVolumeMappingResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	sdcType, err1 := helper.GetSdcType(r.client, state.ID.ValueString())
+	system, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	sdcType, err1 := system.GetSdcByID(state.ID.ValueString())
+	// remove the mapping from the state when the SDC has been removed outside of terraform
+	if helper.IsNotFoundError(err1) {
+		tflog.Warn(ctx, "[POWERFLEX] SDC "+state.ID.ValueString()+" not found, removing its volume mappings from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err1 != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting SDC type: "+state.ID.String(),
@@ -523,7 +542,7 @@ func (r *sdcVolumeMappingResource) Update(ctx context.Context, req resource.Upda
 
 	}
 
-	sdcType, err1 := helper.GetSdcType(r.client, state.ID.ValueString())
+	sdcType, err1 := helper.GetSdcType(r.client, r.systemID, state.ID.ValueString())
 	if err1 != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting SDC type: "+state.ID.String(),
@@ -627,6 +646,13 @@ func (r *sdcVolumeMappingResource) VerifyVolumes(ctx context.Context, plan *mode
 				)
 				return
 			}
+			if len(volume) == 0 {
+				diags.AddError(
+					"Error getting volume with name: ",
+					"volume with name "+vol.VolumeName.ValueString()+" not found",
+				)
+				return
+			}
 			volList[index].VolumeID = types.StringValue(volume[0].ID)
 		}
 	}
@@ -43,7 +43,8 @@ func SDSDataSource() datasource.DataSource {
 
 // sdsDataSource is the data source implementation.
 type sdsDataSource struct {
-	client *goscaleio.Client
+	client   *goscaleio.Client
+	systemID string
 }
 
 // Metadata returns the data source type name.
@@ -62,7 +63,9 @@ func (d *sdsDataSource) Configure(_ context.Context, req datasource.ConfigureReq
 		return
 	}
 
-	d.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	d.client = p.client
+	d.systemID = p.systemID
 }
 
 // sdsCounterModelValue processes the different types of windows information
@@ -145,7 +148,7 @@ func (d *sdsDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	}
 
 	// Get the system on the PowerFlex cluster
-	c2, err := helper.GetFirstSystem(d.client)
+	c2, err := helper.GetSystem(d.client, d.systemID, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error in getting system instance",
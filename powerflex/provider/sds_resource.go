@@ -23,13 +23,16 @@ import (
 
 	scaleiotypes "github.com/dell/goscaleio/types/v1"
 
+	"terraform-provider-powerflex/client"
 	"terraform-provider-powerflex/powerflex/helper"
 	"terraform-provider-powerflex/powerflex/models"
 
 	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
@@ -45,7 +48,8 @@ func NewSDSResource() resource.Resource {
 
 // sdsResource is the resource implementation.
 type sdsResource struct {
-	client *goscaleio.Client
+	client   *goscaleio.Client
+	systemID string
 }
 
 func (r *sdsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -61,7 +65,9 @@ func (r *sdsResource) Configure(_ context.Context, req resource.ConfigureRequest
 		return
 	}
 
-	r.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
 }
 
 func (r *sdsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -109,6 +115,15 @@ func (r *sdsResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	// bound the operation by the create timeout of the resource
+	createTimeout, diags := helper.GetCreateTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// if rmcache size is provided but rmcache is not enabled
 	if !(plan.RmcacheSizeInMB.IsNull() || plan.RmcacheSizeInMB.IsUnknown()) && !plan.RmcacheEnabled.ValueBool() {
 		resp.Diagnostics.AddAttributeError(
@@ -121,7 +136,7 @@ func (r *sdsResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	pdm, err := helper.GetNewProtectionDomainEx(r.client, plan.ProtectionDomainID.ValueString(), plan.ProtectionDomainName.ValueString(), "")
+	pdm, err := helper.GetNewProtectionDomainEx(r.client, helper.SystemIDOrDefault(plan.SystemID, r.systemID), plan.ProtectionDomainID.ValueString(), plan.ProtectionDomainName.ValueString(), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting Protection Domain",
@@ -130,8 +145,9 @@ func (r *sdsResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	// set the protection domain name in the plan so that it gets propagated to the state
+	// set the protection domain name and system ID in the plan so that they get propagated to the state
 	plan.ProtectionDomainName = types.StringValue(pdm.ProtectionDomain.Name)
+	plan.SystemID = types.StringValue(pdm.ProtectionDomain.SystemID)
 
 	sdsName := plan.Name.ValueString()
 	iplist := plan.GetIPList(ctx)
@@ -152,7 +168,22 @@ func (r *sdsResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if !plan.Port.IsUnknown() {
 		params.Port = int(plan.Port.ValueInt64())
 	}
-	sdsID, err2 := pdm.CreateSdsWithParams(&params)
+	faultSet, dgs := r.getFaultSet(ctx, pdm.ProtectionDomain.ID, plan)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sdsID string
+	var err2 error
+	if faultSet != nil {
+		// goscaleio ignores the fault set of the SDS
+		plan.FaultSetName = types.StringValue(faultSet.Name)
+		sdsID, err2 = client.CreateSdsInFaultSet(ctx, r.client, pdm.ProtectionDomain.ID, faultSet.ID, &params)
+	} else {
+		plan.FaultSetName = types.StringNull()
+		sdsID, err2 = pdm.CreateSdsWithParams(&params)
+	}
 	if err2 != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Could not create SDS with name %s and IP list %v", sdsName, iplist),
@@ -226,7 +257,7 @@ func (r *sdsResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get the system on the PowerFlex cluster
-	system, err := helper.GetFirstSystem(r.client)
+	system, err := helper.GetSystem(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error in getting system instance on the PowerFlex cluster",
@@ -234,10 +265,18 @@ func (r *sdsResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		)
 		return
 	}
+	// system ID is not known when SDS is imported
+	state.SystemID = types.StringValue(system.System.ID)
 
 	// Get SDS
 	var rsp scaleiotypes.Sds
 	if rsp, err = system.GetSdsByID(state.ID.ValueString()); err != nil {
+		// remove the SDS from the state when it has been deleted outside of terraform
+		if helper.IsNotFoundError(err) {
+			tflog.Warn(ctx, "[POWERFLEX] SDS "+state.ID.ValueString()+" not found, removing it from the state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Could not get SDS by ID %s", state.ID.ValueString()),
 			err.Error(),
@@ -258,6 +297,19 @@ func (r *sdsResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		}
 	}
 
+	// when SDS is imported, fault set name is not known and this causes a non empty plan
+	if state.FaultSetName.IsNull() && rsp.FaultSetID != "" {
+		faultSet, err := client.GetFaultSet(ctx, r.client, rsp.FaultSetID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to read name of fault set of ID %s for SDS %s", rsp.FaultSetID, rsp.Name),
+				err.Error(),
+			)
+		} else {
+			state.FaultSetName = types.StringValue(faultSet.Name)
+		}
+	}
+
 	// Set refreshed state
 	state, dgs := helper.UpdateSdsState(&rsp, state)
 	resp.Diagnostics.Append(dgs...)
@@ -284,6 +336,15 @@ func (r *sdsResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	// bound the operation by the update timeout of the resource
+	updateTimeout, diags := helper.GetUpdateTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// if rm cache size is provided
 	if !(plan.RmcacheSizeInMB.IsNull() || plan.RmcacheSizeInMB.IsUnknown()) {
 		if plan.RmcacheEnabled.ValueBool() ||
@@ -304,7 +365,7 @@ func (r *sdsResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	pdm, err := helper.GetNewProtectionDomainEx(r.client, state.ProtectionDomainID.ValueString(), state.ProtectionDomainName.ValueString(), "")
+	pdm, err := helper.GetNewProtectionDomainEx(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), state.ProtectionDomainID.ValueString(), state.ProtectionDomainName.ValueString(), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting Protection Domain",
@@ -461,7 +522,16 @@ func (r *sdsResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	pdm, err := helper.GetNewProtectionDomainEx(r.client, state.ProtectionDomainID.ValueString(), state.ProtectionDomainName.ValueString(), "")
+	// bound the operation by the delete timeout of the resource
+	deleteTimeout, diags := helper.GetDeleteTimeout(state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	pdm, err := helper.GetNewProtectionDomainEx(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), state.ProtectionDomainID.ValueString(), state.ProtectionDomainName.ValueString(), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting Protection Domain",
@@ -481,6 +551,27 @@ func (r *sdsResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	// SDS removal is asynchronous, wait till the SDS is gone from the protection domain
+	err = helper.WaitFor(ctx, helper.RemovalPollInterval, func() (bool, error) {
+		sdsList, err := pdm.GetSds()
+		if err != nil {
+			return false, err
+		}
+		for _, sds := range sdsList {
+			if sds.ID == state.ID.ValueString() {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for removal of Powerflex SDS",
+			err.Error(),
+		)
+		return
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -488,6 +579,38 @@ func (r *sdsResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 }
 
+// getFaultSet returns the fault set in which the SDS is placed, given by its ID or its name in the plan,
+// or nil when the SDS is not placed in a fault set
+func (r *sdsResource) getFaultSet(ctx context.Context, pdID string, plan models.SdsResourceModel) (*client.FaultSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var faultSet *client.FaultSet
+	var err error
+	switch {
+	case plan.FaultSetID.ValueString() != "":
+		faultSet, err = client.GetFaultSet(ctx, r.client, plan.FaultSetID.ValueString())
+	case plan.FaultSetName.ValueString() != "":
+		faultSet, err = client.FindProtectionDomainFaultSet(ctx, r.client, pdID, plan.FaultSetName.ValueString())
+	default:
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Error getting Fault Set",
+			err.Error(),
+		)
+		return nil, diags
+	}
+	if faultSet.ProtectionDomainID != pdID {
+		diags.AddAttributeError(
+			path.Root("fault_set_id"),
+			"Invalid Fault Set",
+			fmt.Sprintf("The fault set %s does not belong to the protection domain %s of the SDS", faultSet.ID, pdID),
+		)
+		return nil, diags
+	}
+	return faultSet, diags
+}
+
 func (r *sdsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
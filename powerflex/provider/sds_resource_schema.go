@@ -25,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
@@ -121,9 +123,39 @@ var SDSResourceSchema schema.Schema = schema.Schema{
 			MarkdownDescription: "RMcache frozen state of SDS",
 		},
 		"fault_set_id": schema.StringAttribute{
-			Description:         "Fault set id of SDS",
-			Computed:            true,
-			MarkdownDescription: "Fault set id of SDS",
+			Description: "ID of the fault set in which the SDS will be placed." +
+				" Conflicts with 'fault_set_name'." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "ID of the fault set in which the SDS will be placed." +
+				" Conflicts with `fault_set_name`." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"fault_set_name": schema.StringAttribute{
+			Description: "Name of the fault set of the protection domain in which the SDS will be placed." +
+				" Conflicts with 'fault_set_id'." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "Name of the fault set of the protection domain in which the SDS will be placed." +
+				" Conflicts with `fault_set_id`." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("fault_set_id")),
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
 		},
 		"rmcache_memory_allocation_state": schema.StringAttribute{
 			Description:         "Rmcache memory allocation state of SDS.",
@@ -193,5 +225,25 @@ var SDSResourceSchema schema.Schema = schema.Schema{
 			Computed:            true,
 			MarkdownDescription: "State of SDS",
 		},
+		"system_id": schema.StringAttribute{
+			Description: "ID of the PowerFlex system on which the SDS will be created." +
+				" Defaults to the system configured on the provider." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "ID of the PowerFlex system on which the SDS will be created." +
+				" Defaults to the system configured on the provider." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"timeouts": TimeoutsBlock,
 	},
 }
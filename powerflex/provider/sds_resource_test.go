@@ -318,6 +318,54 @@ func TestAccSDSResourceRmCache(t *testing.T) {
 	})
 }
 
+func TestAccSDSResourceFaultSet(t *testing.T) {
+	sdsConfig := createFaultSetPosTest + `
+		resource "powerflex_sds" "sds" {
+			name = "Tf_SDS_01"
+			ip_list = [
+				{
+					ip = "` + SdsResourceTestData.SdsIP2 + `"
+					role = "all"
+				}
+			]
+			protection_domain_name = "domain1"
+			%s
+		}
+		`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Check that SDS cannot be placed in a fault set that does not exist
+			{
+				Config:      ProviderConfigForTesting + fmt.Sprintf(sdsConfig, `fault_set_name = "invalid"`),
+				ExpectError: regexp.MustCompile(".*Error getting Fault Set.*"),
+			},
+			// Check that SDS can be placed in a fault set by name
+			{
+				Config: ProviderConfigForTesting + fmt.Sprintf(sdsConfig, "fault_set_name = resource.powerflex_fault_set.fs.name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_sds.sds", "fault_set_name", "tfacc-fault-set"),
+					resource.TestCheckResourceAttrPair("powerflex_sds.sds", "fault_set_id", "powerflex_fault_set.fs", "id"),
+				),
+			},
+			// check that import is creating correct state
+			{
+				ResourceName:      "powerflex_sds.sds",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Check that the fault set is kept when it is given by ID instead
+			{
+				Config: ProviderConfigForTesting + fmt.Sprintf(sdsConfig, "fault_set_id = resource.powerflex_fault_set.fs.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_sds.sds", "fault_set_name", "tfacc-fault-set"),
+					resource.TestCheckResourceAttrPair("powerflex_sds.sds", "fault_set_id", "powerflex_fault_set.fs", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSDSResourceCreateWithoutIP(t *testing.T) {
 	createInvalidConfig := `
 		resource "powerflex_sds" "invalid" {
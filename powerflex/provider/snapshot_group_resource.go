@@ -0,0 +1,289 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	pftypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &snapshotGroupResource{}
+	_ resource.ResourceWithConfigure   = &snapshotGroupResource{}
+	_ resource.ResourceWithImportState = &snapshotGroupResource{}
+)
+
+// NewSnapshotGroupResource is a helper function to simplify the provider implementation.
+func NewSnapshotGroupResource() resource.Resource {
+	return &snapshotGroupResource{}
+}
+
+// snapshotGroupResource is the resource implementation.
+type snapshotGroupResource struct {
+	client   *goscaleio.Client
+	systemID string
+}
+
+// Metadata returns the resource type name.
+func (r *snapshotGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *snapshotGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = SnapshotGroupResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *snapshotGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *snapshotGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SnapshotGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sr, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting the system to create the snapshot group on",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	volumes, diags := r.getVolumes(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// all the snapshots are created by a single request so that they are consistent with each other
+	snapshotDefs := make([]*pftypes.SnapshotDef, 0, len(volumes))
+	for _, vol := range volumes {
+		snapshotDef := &pftypes.SnapshotDef{
+			VolumeID: vol.ID,
+		}
+		if !plan.NamePrefix.IsNull() {
+			snapshotDef.SnapshotName = plan.NamePrefix.ValueString() + vol.Name
+		}
+		snapshotDefs = append(snapshotDefs, snapshotDef)
+	}
+	snapResps, err := sr.CreateSnapshotConsistencyGroup(&pftypes.SnapshotVolumesParam{
+		SnapshotDefs: snapshotDefs,
+		AccessMode:   plan.AccessMode.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating snapshot group",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "[POWERFLEX] snapshot group "+snapResps.SnapshotGroupID+" created")
+	plan.ID = types.StringValue(snapResps.SnapshotGroupID)
+
+	snapshots, volumeNames, err := r.getSnapshotGroup(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting snapshot group",
+			"Could not get snapshot group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	dgs := helper.UpdateSnapshotGroupState(snapshots, volumeNames, &plan)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *snapshotGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SnapshotGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshots, volumeNames, err := r.getSnapshotGroup(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting snapshot group",
+			"Could not get snapshot group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	// remove the snapshot group from the state when all its snapshots have been deleted outside of terraform
+	if len(snapshots) == 0 {
+		tflog.Warn(ctx, "[POWERFLEX] snapshot group "+state.ID.ValueString()+" not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	dgs := helper.UpdateSnapshotGroupState(snapshots, volumeNames, &state)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// All the attributes of the snapshot group require a replacement, so there is nothing to update.
+func (r *snapshotGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.SnapshotGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *snapshotGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.SnapshotGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sr, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting the system of the snapshot group",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// all the snapshots are removed by a single request, so that no part of the group is left behind
+	err = client.RemoveConsistencyGroupSnapshots(ctx, r.client, sr.System.ID, state.ID.ValueString())
+	if err != nil && !helper.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error removing snapshot group",
+			"Couldn't remove the snapshots of the snapshot group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the snapshot group by its ID.
+func (r *snapshotGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getVolumes returns the volumes to snapshot, given by their IDs or their names in the plan
+func (r *snapshotGroupResource) getVolumes(ctx context.Context, plan models.SnapshotGroupResourceModel) (volumes []*pftypes.Volume, diags diag.Diagnostics) {
+	var volumeIDs, volumeNames []string
+	if !plan.VolumeIDs.IsNull() {
+		diags.Append(plan.VolumeIDs.ElementsAs(ctx, &volumeIDs, true)...)
+	}
+	if !plan.VolumeNames.IsNull() {
+		diags.Append(plan.VolumeNames.ElementsAs(ctx, &volumeNames, true)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, id := range volumeIDs {
+		vols, err := r.client.GetVolume("", id, "", "", false)
+		if err != nil {
+			diags.AddError(
+				"Error getting volume by id",
+				"unexpected error: "+err.Error(),
+			)
+			return nil, diags
+		}
+		if len(vols) == 0 {
+			diags.AddError(
+				"Error getting volume by id",
+				"volume with id "+id+" not found",
+			)
+			return nil, diags
+		}
+		volumes = append(volumes, vols[0])
+	}
+	for _, name := range volumeNames {
+		vols, err := r.client.GetVolume("", "", "", name, false)
+		if err != nil {
+			diags.AddError(
+				"Error getting volume by name",
+				"unexpected error: "+err.Error(),
+			)
+			return nil, diags
+		}
+		if len(vols) == 0 {
+			diags.AddError(
+				"Error getting volume by name",
+				"volume with name "+name+" not found",
+			)
+			return nil, diags
+		}
+		volumes = append(volumes, vols[0])
+	}
+	return volumes, diags
+}
+
+// getSnapshotGroup returns the snapshots of the snapshot group and the names of their volumes
+func (r *snapshotGroupResource) getSnapshotGroup(groupID string) ([]*pftypes.Volume, map[string]string, error) {
+	allSnapshots, err := r.client.GetVolume("", "", "", "", true)
+	if err != nil {
+		return nil, nil, err
+	}
+	snapshots := helper.GetSnapshotGroupMembers(groupID, allSnapshots)
+
+	volumeNames := map[string]string{}
+	for _, snap := range snapshots {
+		vols, err := r.client.GetVolume("", snap.AncestorVolumeID, "", "", false)
+		// the volume may have been removed since the snapshot was taken
+		if helper.IsNotFoundError(err) || (err == nil && len(vols) == 0) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		volumeNames[snap.AncestorVolumeID] = vols[0].Name
+	}
+	return snapshots, volumeNames, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SnapshotGroupResourceSchema variable to define schema for the snapshot group resource
+var SnapshotGroupResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource can be used to create crash-consistent snapshots of several volumes on a PowerFlex array." +
+		" The snapshots of all the volumes are taken at the same point in time and belong to the same snapshot group.",
+	MarkdownDescription: "This resource can be used to create crash-consistent snapshots of several volumes on a PowerFlex array." +
+		" The snapshots of all the volumes are taken at the same point in time and belong to the same snapshot group.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the snapshot group.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the snapshot group.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"volume_ids": schema.SetAttribute{
+			Description: "The IDs of the volumes to snapshot." +
+				" Conflicts with 'volume_names'." +
+				" Cannot be updated.",
+			MarkdownDescription: "The IDs of the volumes to snapshot." +
+				" Conflicts with `volume_names`." +
+				" Cannot be updated.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				setvalidator.ExactlyOneOf(path.MatchRoot("volume_names")),
+			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+		"volume_names": schema.SetAttribute{
+			Description: "The names of the volumes to snapshot." +
+				" Conflicts with 'volume_ids'." +
+				" Cannot be updated.",
+			MarkdownDescription: "The names of the volumes to snapshot." +
+				" Conflicts with `volume_ids`." +
+				" Cannot be updated.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				setvalidator.ExactlyOneOf(path.MatchRoot("volume_ids")),
+			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+		"name_prefix": schema.StringAttribute{
+			Description: "The prefix of the names of the snapshots, each snapshot is named after the prefix followed by the name of its volume." +
+				" The names are generated by PowerFlex when not set." +
+				" Cannot be updated.",
+			MarkdownDescription: "The prefix of the names of the snapshots, each snapshot is named after the prefix followed by the name of its volume." +
+				" The names are generated by PowerFlex when not set." +
+				" Cannot be updated.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"access_mode": schema.StringAttribute{
+			Description: "The access mode of the snapshots. Valid values are 'ReadOnly' and 'ReadWrite'. Default value is 'ReadOnly'." +
+				" Cannot be updated.",
+			MarkdownDescription: "The access mode of the snapshots. Valid values are `ReadOnly` and `ReadWrite`. Default value is `ReadOnly`." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{stringvalidator.OneOf(
+				"ReadOnly",
+				"ReadWrite",
+			)},
+			PlanModifiers: []planmodifier.String{
+				helper.StringDefault("ReadOnly"),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"snapshots": schema.ListNestedAttribute{
+			Description:         "The snapshots of the snapshot group.",
+			MarkdownDescription: "The snapshots of the snapshot group.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "The ID of the snapshot.",
+						MarkdownDescription: "The ID of the snapshot.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "The name of the snapshot.",
+						MarkdownDescription: "The name of the snapshot.",
+						Computed:            true,
+					},
+					"volume_id": schema.StringAttribute{
+						Description:         "The ID of the volume the snapshot was taken from.",
+						MarkdownDescription: "The ID of the volume the snapshot was taken from.",
+						Computed:            true,
+					},
+					"volume_name": schema.StringAttribute{
+						Description:         "The name of the volume the snapshot was taken from.",
+						MarkdownDescription: "The name of the volume the snapshot was taken from.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var createSnapshotGroupPosTest = createVolForSs + `
+resource "powerflex_snapshot_group" "snapshot-group" {
+	volume_ids = [resource.powerflex_volume.ref-vol.id, resource.powerflex_volume.ref-vol-16gb.id]
+	name_prefix = "sg-"
+}
+`
+
+var createSnapshotGroupByNamePosTest = createVolForSs + `
+resource "powerflex_snapshot_group" "snapshot-group" {
+	volume_names = [resource.powerflex_volume.ref-vol.name, resource.powerflex_volume.ref-vol-16gb.name]
+	name_prefix = "sg-rw-"
+	access_mode = "ReadWrite"
+}
+`
+
+var createSnapshotGroupWithInvalidVolumeName = createVolForSs + `
+resource "powerflex_snapshot_group" "snapshot-group" {
+	volume_names = [resource.powerflex_volume.ref-vol.name, "inv"]
+}
+`
+
+var createSnapshotGroupWithIDsAndNames = createVolForSs + `
+resource "powerflex_snapshot_group" "snapshot-group" {
+	volume_ids = [resource.powerflex_volume.ref-vol.id]
+	volume_names = [resource.powerflex_volume.ref-vol-16gb.name]
+}
+`
+
+func TestAccSnapshotGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createSnapshotGroupWithIDsAndNames,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createSnapshotGroupPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerflex_snapshot_group.snapshot-group", "id"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_group.snapshot-group", "access_mode", "ReadOnly"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_group.snapshot-group", "snapshots.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerflex_snapshot_group.snapshot-group", "snapshots.*", map[string]string{
+						"name":        "sg-tfaccp-ssvol-test",
+						"volume_name": "tfaccp-ssvol-test",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("powerflex_snapshot_group.snapshot-group", "snapshots.*", map[string]string{
+						"name":        "sg-tfaccp-16gb-ssvol-test",
+						"volume_name": "tfaccp-16gb-ssvol-test",
+					}),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:            "powerflex_snapshot_group.snapshot-group",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_prefix"},
+			},
+			// changing the volumes replaces the snapshot group
+			{
+				Config: ProviderConfigForTesting + createSnapshotGroupByNamePosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_snapshot_group.snapshot-group", "access_mode", "ReadWrite"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_group.snapshot-group", "snapshots.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerflex_snapshot_group.snapshot-group", "snapshots.*", map[string]string{
+						"name": "sg-rw-tfaccp-ssvol-test",
+					}),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + createSnapshotGroupWithInvalidVolumeName,
+				ExpectError: regexp.MustCompile(`.*Error getting volume by name*.`),
+			},
+		},
+	})
+}
@@ -50,7 +50,8 @@ func NewSnapshotResource() resource.Resource {
 
 // snapshotResource is the resource implementation.
 type snapshotResource struct {
-	client *goscaleio.Client
+	client   *goscaleio.Client
+	systemID string
 }
 
 // Metadata returns the resource type name.
@@ -69,7 +70,9 @@ func (r *snapshotResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
 }
 
 // ModifyPlan modify resource plan attribute value
@@ -89,6 +92,22 @@ func (r *snapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 		plan.Size = basetypes.NewInt64Unknown()
 		plan.SizeInKb = basetypes.NewInt64Unknown()
 	}
+
+	// the state is null when the snapshot is created
+	var state models.SnapshotResourceModel
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = r.checkSize(plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !plan.DesiredRetention.IsNull() && !plan.DesiredRetention.IsUnknown() {
 		retentionInMin := helper.ConvertToMin(plan.DesiredRetention.ValueInt64(), plan.RetentionUnit.ValueString())
 		plan.RetentionInMin = types.StringValue(retentionInMin)
@@ -104,13 +123,45 @@ func (r *snapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 	resp.Diagnostics.Append(diags...)
 }
 
+// checkSize rejects a planned size that is not in the allocation granularity or that shrinks the snapshot,
+// and warns when the storage pool lacks the capacity to grow the snapshot.
+// A new snapshot has the size of its volume before it is resized.
+func (r *snapshotResource) checkSize(plan, state models.SnapshotResourceModel) diag.Diagnostics {
+	if plan.SizeInKb.IsUnknown() || plan.SizeInKb.Equal(state.SizeInKb) {
+		return nil
+	}
+
+	// the volume being resized: the snapshot itself, or the volume it is taken from when it is created.
+	// The provider is not configured when the configuration is only validated.
+	var volume *pftypes.Volume
+	if r.client != nil {
+		var volumes []*pftypes.Volume
+		var err error
+		if !state.ID.IsNull() {
+			volumes, err = r.client.GetVolume("", state.ID.ValueString(), "", "", false)
+		} else if plan.VolumeID.ValueString() != "" || plan.VolumeName.ValueString() != "" {
+			volumes, err = r.client.GetVolume("", plan.VolumeID.ValueString(), "", plan.VolumeName.ValueString(), false)
+		}
+		// a volume that cannot be found is reported when the snapshot is created
+		if err == nil && len(volumes) == 1 {
+			volume = volumes[0]
+		}
+	}
+
+	currentSizeInKb := state.SizeInKb.ValueInt64()
+	if state.ID.IsNull() && volume != nil {
+		currentSizeInKb = int64(volume.SizeInKb)
+	}
+	return helper.CheckVolumeResize(r.client, r.systemID, volume, plan.SizeInKb.ValueInt64(), currentSizeInKb)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *snapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan models.SnapshotResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	errMsg := make(map[string]string, 0)
-	sr, err := helper.GetFirstSystem(r.client)
+	sr, err := helper.GetSystem(r.client, r.systemID, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting first system",
@@ -238,6 +289,12 @@ func (r *snapshotResource) Read(ctx context.Context, req resource.ReadRequest, r
 	resp.Diagnostics.Append(diags...)
 	errMsg := make(map[string]string, 0)
 	snapResponse, err2 := r.client.GetVolume("", state.ID.ValueString(), "", "", false)
+	// remove the snapshot from the state when it has been deleted outside of terraform
+	if helper.IsNotFoundError(err2) || (err2 == nil && len(snapResponse) == 0) {
+		tflog.Warn(ctx, "[POWERFLEX] snapshot "+state.ID.ValueString()+" not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err2 != nil {
 		resp.Diagnostics.AddError(
 			"Error getting snapshot",
@@ -252,7 +309,7 @@ func (r *snapshotResource) Read(ctx context.Context, req resource.ReadRequest, r
 	vol, errVol := r.client.GetVolume("", state.VolumeID.ValueString(), "", "", false)
 	if errVol != nil {
 		errMsg["volume_name"] = errVol.Error()
-	} else {
+	} else if len(vol) > 0 {
 		state.VolumeName = types.StringValue(vol[0].Name)
 	}
 	resp.Diagnostics.Append(diags...)
@@ -427,6 +484,13 @@ func (r *snapshotResource) getVolumeID(ctx context.Context, plan *models.Snapsho
 			)
 			return
 		}
+		if len(snapResponse) == 0 {
+			diags.AddError(
+				"Error getting volume by name",
+				"volume with name "+plan.VolumeName.ValueString()+" not found",
+			)
+			return
+		}
 		plan.VolumeID = types.StringValue(snapResponse[0].ID)
 	} else if !plan.VolumeID.IsUnknown() {
 		tflog.Info(ctx, fmt.Sprintf("Volume id is provided: %s", plan.VolumeID.ValueString()))
@@ -98,11 +98,13 @@ var SnapshotResourceSchema schema.Schema = schema.Schema{
 		},
 		"size": schema.Int64Attribute{
 			Description: "Size of the snapshot. The unit of size is defined by 'capacity_unit'." +
-				" The storage capacity of a snapshot must be a multiple of 8GB and cannot be decreased.",
+				" The storage capacity of a snapshot must be a multiple of 8GB and cannot be decreased, which is checked when planning." +
+				" A warning is raised when the storage pool lacks the capacity for the new size.",
 			Optional: true,
 			Computed: true,
 			MarkdownDescription: "Size of the snapshot. The unit of size is defined by `capacity_unit`." +
-				" The storage capacity of a snapshot must be a multiple of 8GB and cannot be decreased.",
+				" The storage capacity of a snapshot must be a multiple of 8GB and cannot be decreased, which is checked when planning." +
+				" A warning is raised when the storage pool lacks the capacity for the new size.",
 		},
 		"capacity_unit": schema.StringAttribute{
 			Description:         "Unit of capacity of the volume. Must be one of 'GB' and 'TB'. Default value is 'GB'.",
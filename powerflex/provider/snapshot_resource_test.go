@@ -76,6 +76,17 @@ resource "powerflex_snapshot" "snapshots-create" {
 }
 `
 
+// the size of a snapshot cannot be decreased, the plan fails
+var updateSnapshotShrinkNegTest = createVolForSs + `
+resource "powerflex_snapshot" "snapshots-create" {
+	name = "snapshots-create-1"
+	volume_id = resource.powerflex_volume.ref-vol.id
+	size = 16
+	capacity_unit="GB"
+	access_mode = "ReadWrite"
+}
+`
+
 var updateSnapshotRenameNegTest = createVolForSs + `
 resource "powerflex_snapshot" "snapshots-create-before" {
 	name = "snapshot-create-invalid"
@@ -179,13 +190,17 @@ func TestAccSnapshotResource(t *testing.T) {
 				Config:      ProviderConfigForTesting + updateSnapshotResizeNegTest,
 				ExpectError: regexp.MustCompile(`.*Requested volume size exceeds the volume allocation limit*.`),
 			},
+			{
+				Config:      ProviderConfigForTesting + updateSnapshotShrinkNegTest,
+				ExpectError: regexp.MustCompile(`.*Size cannot be decreased*.`),
+			},
 			{
 				Config:      ProviderConfigForTesting + updateSnapshotRenameNegTest,
 				ExpectError: regexp.MustCompile(`.*Volume name already in use*.`),
 			},
 			{
 				Config:      ProviderConfigForTesting + createSnapshotWithlowSizeNegTest,
-				ExpectError: regexp.MustCompile(`.*Volume capacity can only be increased*.`),
+				ExpectError: regexp.MustCompile(`.*Size cannot be decreased*.`),
 			},
 			{
 				Config:      ProviderConfigForTesting + createSnapshotWithhighSizeNegTest,
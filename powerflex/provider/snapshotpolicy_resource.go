@@ -0,0 +1,344 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	pftypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &snapshotPolicyResource{}
+	_ resource.ResourceWithConfigure   = &snapshotPolicyResource{}
+	_ resource.ResourceWithImportState = &snapshotPolicyResource{}
+)
+
+// NewSnapshotPolicyResource is a helper function to simplify the provider implementation.
+func NewSnapshotPolicyResource() resource.Resource {
+	return &snapshotPolicyResource{}
+}
+
+// snapshotPolicyResource is the resource implementation.
+type snapshotPolicyResource struct {
+	client *goscaleio.Client
+}
+
+// Metadata returns the resource type name.
+func (r *snapshotPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_policy"
+}
+
+// Schema defines the schema for the resource.
+func (r *snapshotPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = SnapshotPolicyResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *snapshotPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *snapshotPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SnapshotPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retained, diags := helper.GetRetainedSnapshotsPerLevel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, err := client.CreateSnapshotPolicy(ctx, r.client, &client.SnapshotPolicyCreateParam{
+		Name:                             plan.Name.ValueString(),
+		AutoSnapshotCreationCadenceInMin: strconv.FormatInt(plan.AutoSnapshotCreationCadenceInMin.ValueInt64(), 10),
+		NumOfRetainedSnapshotsPerLevel:   retained,
+		SnapshotAccessMode:               plan.SnapshotAccessMode.ValueString(),
+		SecureSnapshots:                  strconv.FormatBool(plan.SecureSnapshots.ValueBool()),
+		Paused:                           strconv.FormatBool(plan.Paused.ValueBool()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating snapshot policy",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "[POWERFLEX] snapshot policy "+id+" created")
+
+	// the source volumes are attached once the policy exists, a failure keeps the policy in the state
+	errMsg := r.updateSourceVolumes(ctx, id, plan, nil)
+
+	state := plan
+	state.ID = types.StringValue(id)
+	dgs := r.readSnapshotPolicy(ctx, id, &state)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	addFailureMessage(&resp.Diagnostics, errMsg)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *snapshotPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SnapshotPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sps, err := r.client.GetSnapshotPolicy("", state.ID.ValueString())
+	// remove the snapshot policy from the state when it has been deleted outside of terraform
+	if helper.IsNotFoundError(err) || (err == nil && len(sps) == 0) {
+		tflog.Warn(ctx, "[POWERFLEX] snapshot policy "+state.ID.ValueString()+" not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting snapshot policy",
+			"Could not get snapshot policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	dgs := r.updateSnapshotPolicyState(ctx, sps[0], &state)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *snapshotPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.SnapshotPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var state models.SnapshotPolicyResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+	errMsg := make(map[string]string, 0)
+
+	// renaming the snapshot policy if there is change in plan
+	if plan.Name.ValueString() != state.Name.ValueString() {
+		if err := client.RenameSnapshotPolicy(ctx, r.client, id, plan.Name.ValueString()); err != nil {
+			errMsg["name"] = err.Error()
+		}
+	}
+
+	// modifying the schedule if the cadence or the retention levels change
+	if !plan.AutoSnapshotCreationCadenceInMin.Equal(state.AutoSnapshotCreationCadenceInMin) ||
+		!plan.NumOfRetainedSnapshotsPerLevel.Equal(state.NumOfRetainedSnapshotsPerLevel) {
+		retained, dgs := helper.GetRetainedSnapshotsPerLevel(ctx, plan)
+		resp.Diagnostics.Append(dgs...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := client.ModifySnapshotPolicy(ctx, r.client, id, &client.SnapshotPolicyModifyParam{
+			AutoSnapshotCreationCadenceInMin: strconv.FormatInt(plan.AutoSnapshotCreationCadenceInMin.ValueInt64(), 10),
+			NumOfRetainedSnapshotsPerLevel:   retained,
+		})
+		if err != nil {
+			errMsg["auto_snapshot_creation_cadence_in_min/num_of_retained_snapshots_per_level"] = err.Error()
+		}
+	}
+
+	// attaching and detaching the source volumes
+	for key, value := range r.updateSourceVolumes(ctx, id, plan, &state) {
+		errMsg[key] = value
+	}
+
+	// pausing or resuming the snapshot policy if there is change in plan
+	if plan.Paused.ValueBool() != state.Paused.ValueBool() {
+		var err error
+		if plan.Paused.ValueBool() {
+			err = client.PauseSnapshotPolicy(ctx, r.client, id)
+		} else {
+			err = client.ResumeSnapshotPolicy(ctx, r.client, id)
+		}
+		if err != nil {
+			errMsg["paused"] = err.Error()
+		}
+	}
+
+	newState := plan
+	dgs := r.readSnapshotPolicy(ctx, id, &newState)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+	addFailureMessage(&resp.Diagnostics, errMsg)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *snapshotPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.SnapshotPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	// a snapshot policy can only be removed once its source volumes are detached
+	volumes, err := client.GetSnapshotPolicySourceVolumes(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting the source volumes of the snapshot policy",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	for _, vol := range volumes {
+		err := client.RemoveSourceVolumeFromSnapshotPolicy(ctx, r.client, id, vol.ID, state.RemoveMode.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error detaching source volume "+vol.ID,
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err = client.RemoveSnapshotPolicy(ctx, r.client, id)
+	if err != nil && !helper.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error removing snapshot policy",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the snapshot policy by its ID.
+func (r *snapshotPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateSourceVolumes attaches the volumes of the plan which are not in the state and detaches the others,
+// it returns the errors by attribute.
+func (r *snapshotPolicyResource) updateSourceVolumes(ctx context.Context, id string, plan models.SnapshotPolicyResourceModel, state *models.SnapshotPolicyResourceModel) map[string]string {
+	errMsg := make(map[string]string, 0)
+	var planIDs, stateIDs []string
+	if plan.VolumeIDs.IsUnknown() {
+		return errMsg
+	}
+	if diags := plan.VolumeIDs.ElementsAs(ctx, &planIDs, true); diags.HasError() {
+		errMsg["volume_ids"] = "unable to read the volume IDs of the plan"
+		return errMsg
+	}
+	if state != nil {
+		if diags := state.VolumeIDs.ElementsAs(ctx, &stateIDs, true); diags.HasError() {
+			errMsg["volume_ids"] = "unable to read the volume IDs of the state"
+			return errMsg
+		}
+	}
+
+	toAttach, toDetach := helper.Difference(planIDs, stateIDs), helper.Difference(stateIDs, planIDs)
+	var failures []string
+	for _, volumeID := range toDetach {
+		if err := client.RemoveSourceVolumeFromSnapshotPolicy(ctx, r.client, id, volumeID, plan.RemoveMode.ValueString()); err != nil {
+			failures = append(failures, "detaching "+volumeID+": "+err.Error())
+		}
+	}
+	for _, volumeID := range toAttach {
+		if err := client.AddSourceVolumeToSnapshotPolicy(ctx, r.client, id, volumeID); err != nil {
+			failures = append(failures, "attaching "+volumeID+": "+err.Error())
+		}
+	}
+	if len(failures) > 0 {
+		errMsg["volume_ids"] = strings.Join(failures, "; ")
+	}
+	return errMsg
+}
+
+// readSnapshotPolicy refreshes the state from the snapshot policy and its source volumes
+func (r *snapshotPolicyResource) readSnapshotPolicy(ctx context.Context, id string, state *models.SnapshotPolicyResourceModel) (diags diag.Diagnostics) {
+	sps, err := r.client.GetSnapshotPolicy("", id)
+	if err != nil {
+		diags.AddError(
+			"Error getting snapshot policy",
+			"Could not get snapshot policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if len(sps) == 0 {
+		diags.AddError(
+			"Error getting snapshot policy",
+			"snapshot policy with ID "+id+" not found",
+		)
+		return
+	}
+	return r.updateSnapshotPolicyState(ctx, sps[0], state)
+}
+
+// updateSnapshotPolicyState refreshes the state from a snapshot policy and reads its source volumes
+func (r *snapshotPolicyResource) updateSnapshotPolicyState(ctx context.Context, sp *pftypes.SnapshotPolicy, state *models.SnapshotPolicyResourceModel) (diags diag.Diagnostics) {
+	volumes, err := client.GetSnapshotPolicySourceVolumes(ctx, r.client, sp.ID)
+	if err != nil {
+		diags.AddError(
+			"Error getting the source volumes of the snapshot policy",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	return helper.UpdateSnapshotPolicyResourceState(sp, volumes, state)
+}
+
+// addFailureMessage adds a single error listing the failures of the operations of a create or an update by attribute
+func addFailureMessage(diags *diag.Diagnostics, errMsg map[string]string) {
+	if len(errMsg) == 0 {
+		return
+	}
+	failureMessage := ""
+	for key, value := range errMsg {
+		failureMessage += key + " : " + value + ", "
+	}
+	failureMessage = strings.TrimSuffix(failureMessage, ", ")
+	diags.AddError(
+		fmt.Sprintf("Failure Message: [%v]", failureMessage),
+		failureMessage)
+}
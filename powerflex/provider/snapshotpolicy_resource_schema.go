@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SnapshotPolicyResourceSchema variable to define schema for the snapshot policy resource
+var SnapshotPolicyResourceSchema schema.Schema = schema.Schema{
+	Description:         "This resource can be used to manage snapshot policies on a PowerFlex array.",
+	MarkdownDescription: "This resource can be used to manage snapshot policies on a PowerFlex array.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the snapshot policy.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the snapshot policy.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the snapshot policy.",
+			Required:            true,
+			MarkdownDescription: "The name of the snapshot policy.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"auto_snapshot_creation_cadence_in_min": schema.Int64Attribute{
+			Description:         "The time in minutes between the creations of the auto snapshots.",
+			Required:            true,
+			MarkdownDescription: "The time in minutes between the creations of the auto snapshots.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"num_of_retained_snapshots_per_level": schema.ListAttribute{
+			Description: "The number of snapshots retained at each level of the retention schedule." +
+				" The first level retains the snapshots created at every cadence, every following level retains one snapshot out of the count of the previous level." +
+				" Between 1 and 6 levels, retaining at most 60 snapshots in total.",
+			MarkdownDescription: "The number of snapshots retained at each level of the retention schedule." +
+				" The first level retains the snapshots created at every cadence, every following level retains one snapshot out of the count of the previous level." +
+				" Between 1 and 6 levels, retaining at most 60 snapshots in total.",
+			ElementType: types.Int64Type,
+			Required:    true,
+			Validators: []validator.List{
+				listvalidator.SizeBetween(1, 6),
+				listvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+			},
+		},
+		"paused": schema.BoolAttribute{
+			Description:         "Whether the creation of the auto snapshots is paused. Default value is 'false'.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Whether the creation of the auto snapshots is paused. Default value is `false`.",
+			PlanModifiers: []planmodifier.Bool{
+				helper.BoolDefault(false),
+			},
+		},
+		"secure_snapshots": schema.BoolAttribute{
+			Description: "Whether the auto snapshots are secure, a secure snapshot cannot be removed before its expiration." +
+				" Default value is 'false'." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "Whether the auto snapshots are secure, a secure snapshot cannot be removed before its expiration." +
+				" Default value is `false`." +
+				" Cannot be updated.",
+			PlanModifiers: []planmodifier.Bool{
+				helper.BoolDefault(false),
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"snapshot_access_mode": schema.StringAttribute{
+			Description: "The access mode of the auto snapshots. Valid values are 'ReadOnly' and 'ReadWrite'. Default value is 'ReadOnly'." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The access mode of the auto snapshots. Valid values are `ReadOnly` and `ReadWrite`. Default value is `ReadOnly`." +
+				" Cannot be updated.",
+			Validators: []validator.String{stringvalidator.OneOf(
+				"ReadOnly",
+				"ReadWrite",
+			)},
+			PlanModifiers: []planmodifier.String{
+				helper.StringDefault("ReadOnly"),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"volume_ids": schema.SetAttribute{
+			Description: "The IDs of the source volumes attached to the snapshot policy." +
+				" The volumes attached outside of Terraform are detached when not listed.",
+			MarkdownDescription: "The IDs of the source volumes attached to the snapshot policy." +
+				" The volumes attached outside of Terraform are detached when not listed.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"remove_mode": schema.StringAttribute{
+			Description: "What happens to the auto snapshots of a source volume detached from the snapshot policy." +
+				" Valid values are 'Detach' to keep them as regular snapshots and 'Remove' to remove them. Default value is 'Detach'.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "What happens to the auto snapshots of a source volume detached from the snapshot policy." +
+				" Valid values are `Detach` to keep them as regular snapshots and `Remove` to remove them. Default value is `Detach`.",
+			Validators: []validator.String{stringvalidator.OneOf(
+				"Detach",
+				"Remove",
+			)},
+			PlanModifiers: []planmodifier.String{
+				helper.StringDefault("Detach"),
+			},
+		},
+		"system_id": schema.StringAttribute{
+			Description:         "The ID of the PowerFlex system of the snapshot policy.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the PowerFlex system of the snapshot policy.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
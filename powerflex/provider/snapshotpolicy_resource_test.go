@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var createSnapshotPolicyPosTest = createVolForSs + `
+resource "powerflex_snapshot_policy" "policy" {
+	name = "tfacc-snap-policy"
+	auto_snapshot_creation_cadence_in_min = 60
+	num_of_retained_snapshots_per_level = [4, 2]
+	paused = true
+	volume_ids = [resource.powerflex_volume.ref-vol.id]
+}
+`
+
+var updateSnapshotPolicyPosTest = createVolForSs + `
+resource "powerflex_snapshot_policy" "policy" {
+	name = "tfacc-snap-policy-1"
+	auto_snapshot_creation_cadence_in_min = 30
+	num_of_retained_snapshots_per_level = [6]
+	paused = false
+	volume_ids = [resource.powerflex_volume.ref-vol-16gb.id]
+	remove_mode = "Remove"
+}
+`
+
+var updateSnapshotPolicyNegTest = createVolForSs + `
+resource "powerflex_snapshot_policy" "policy" {
+	name = "tfacc-snap-policy-1"
+	auto_snapshot_creation_cadence_in_min = 30
+	num_of_retained_snapshots_per_level = [50, 20]
+	volume_ids = [resource.powerflex_volume.ref-vol-16gb.id]
+}
+`
+
+var createSnapshotPolicyWithInvalidLevels = `
+resource "powerflex_snapshot_policy" "policy-invalid" {
+	name = "tfacc-snap-policy-invalid"
+	auto_snapshot_creation_cadence_in_min = 60
+	num_of_retained_snapshots_per_level = [1, 1, 1, 1, 1, 1, 1]
+}
+`
+
+func TestAccSnapshotPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createSnapshotPolicyWithInvalidLevels,
+				ExpectError: regexp.MustCompile(`.*list must contain at least 1 elements and at most 6 elements*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createSnapshotPolicyPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "name", "tfacc-snap-policy"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "paused", "true"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "secure_snapshots", "false"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "snapshot_access_mode", "ReadOnly"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "num_of_retained_snapshots_per_level.#", "2"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "num_of_retained_snapshots_per_level.1", "2"),
+					resource.TestCheckTypeSetElemAttrPair("powerflex_snapshot_policy.policy", "volume_ids.*", "powerflex_volume.ref-vol", "id"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_snapshot_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + updateSnapshotPolicyPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "name", "tfacc-snap-policy-1"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "auto_snapshot_creation_cadence_in_min", "30"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "num_of_retained_snapshots_per_level.#", "1"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "paused", "false"),
+					resource.TestCheckResourceAttr("powerflex_snapshot_policy.policy", "volume_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("powerflex_snapshot_policy.policy", "volume_ids.*", "powerflex_volume.ref-vol-16gb", "id"),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + updateSnapshotPolicyNegTest,
+				ExpectError: regexp.MustCompile(`.*exceeds the maximum of 60*.`),
+			},
+		},
+	})
+}
@@ -42,7 +42,8 @@ func StoragePoolDataSource() datasource.DataSource {
 
 // storagepoolDataSource is the data source implementation.
 type storagepoolDataSource struct {
-	client *goscaleio.Client
+	client   *goscaleio.Client
+	systemID string
 }
 
 // Metadata returns the data source type name.
@@ -61,7 +62,9 @@ func (d *storagepoolDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	d.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	d.client = p.client
+	d.systemID = p.systemID
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -79,7 +82,7 @@ func (d *storagepoolDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Get the systems on the PowerFlex cluster
-	c2, err := helper.GetFirstSystem(d.client)
+	c2, err := helper.GetSystem(d.client, d.systemID, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error in getting system instance",
@@ -45,7 +45,8 @@ func StoragepoolResource() resource.Resource {
 }
 
 type storagepoolResource struct {
-	client *goscaleio.Client
+	client   *goscaleio.Client
+	systemID string
 }
 
 func (r *storagepoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,7 +61,9 @@ func (r *storagepoolResource) Configure(_ context.Context, req resource.Configur
 	if req.ProviderData == nil {
 		return
 	}
-	r.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
 }
 
 func (r *storagepoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -199,7 +202,16 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	pd, err := helper.GetNewProtectionDomainEx(r.client, plan.ProtectionDomainID.ValueString(), plan.ProtectionDomainName.ValueString(), "")
+	// bound the operation by the create timeout of the resource
+	createTimeout, diags := helper.GetCreateTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	pd, err := helper.GetNewProtectionDomainEx(r.client, helper.SystemIDOrDefault(plan.SystemID, r.systemID), plan.ProtectionDomainID.ValueString(), plan.ProtectionDomainName.ValueString(), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting Protection Domain",
@@ -209,6 +221,7 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	plan.ProtectionDomainName = types.StringValue(pd.ProtectionDomain.Name)
+	plan.SystemID = types.StringValue(pd.ProtectionDomain.SystemID)
 	payload := &scaleiotypes.StoragePoolParam{
 		Name:      plan.Name.ValueString(),
 		MediaType: plan.MediaType.ValueString(),
@@ -246,7 +259,11 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// create the storage pool
-	sp, err := pd.CreateStoragePool(payload)
+	var sp string
+	err = helper.RunWithContext(ctx, func() (err error) {
+		sp, err = pd.CreateStoragePool(payload)
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Storage Pool",
@@ -267,7 +284,9 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 
 	// set the replication journal capacity
 	if !plan.ReplicationJournalCapacity.IsUnknown() && !plan.ReplicationJournalCapacity.IsNull() {
-		err := pd.SetReplicationJournalCapacity(sp, plan.ReplicationJournalCapacity.String())
+		err := helper.RunWithContext(ctx, func() error {
+			return pd.SetReplicationJournalCapacity(sp, plan.ReplicationJournalCapacity.String())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Could not set replication Journal capacity to %s", plan.ReplicationJournalCapacity.String()),
@@ -278,7 +297,9 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 
 	// set the capacity alert threshold - high or critical
 	if capacityAlertThresholdParam, ok := helper.IsCritcalAlert(plan, initialState); !ok {
-		errSetCapacityAlertThreshold := pd.SetCapacityAlertThreshold(initialSpResponse.ID, capacityAlertThresholdParam)
+		errSetCapacityAlertThreshold := helper.RunWithContext(ctx, func() error {
+			return pd.SetCapacityAlertThreshold(initialSpResponse.ID, capacityAlertThresholdParam)
+		})
 		if errSetCapacityAlertThreshold != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating Capacity Alert Thresholds of Storagepool", errSetCapacityAlertThreshold.Error(),
@@ -300,7 +321,9 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 		if strconv.FormatInt(plan.ProtectedMaintenanceModeBwLimitPerDeviceInKbps.ValueInt64(), 10) != "0" {
 			protectedMaintenance.BwLimitPerDeviceInKbps = strconv.FormatInt(plan.ProtectedMaintenanceModeBwLimitPerDeviceInKbps.ValueInt64(), 10)
 		}
-		err := pd.SetProtectedMaintenanceModeIoPriorityPolicy(sp, protectedMaintenance)
+		err := helper.RunWithContext(ctx, func() error {
+			return pd.SetProtectedMaintenanceModeIoPriorityPolicy(sp, protectedMaintenance)
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Could not set protected maintenance mode Io priority policy  to %s", plan.ProtectedMaintenanceModeIoPriorityPolicy.String()),
@@ -311,7 +334,9 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 
 	// set rebalance enabled
 	if !plan.RebalanceEnabled.IsUnknown() && !plan.RebalanceEnabled.IsNull() {
-		err := pd.SetRebalanceEnabled(sp, plan.RebalanceEnabled.String())
+		err := helper.RunWithContext(ctx, func() error {
+			return pd.SetRebalanceEnabled(sp, plan.RebalanceEnabled.String())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Could not set rebalance enabled to %s", plan.RebalanceEnabled.String()),
@@ -333,7 +358,9 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 		if strconv.FormatInt(plan.RebalanceBwLimitPerDeviceInKbps.ValueInt64(), 10) != "0" {
 			rebalanceIoPriorityPolicy.BwLimitPerDeviceInKbps = strconv.FormatInt(plan.RebalanceBwLimitPerDeviceInKbps.ValueInt64(), 10)
 		}
-		err := pd.SetRebalanceIoPriorityPolicy(sp, rebalanceIoPriorityPolicy)
+		err := helper.RunWithContext(ctx, func() error {
+			return pd.SetRebalanceIoPriorityPolicy(sp, rebalanceIoPriorityPolicy)
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Could not set rebalance Io priority policy  to %s", plan.RebalanceIoPriorityPolicy.String()),
@@ -355,7 +382,9 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 		if strconv.FormatInt(plan.VtreeMigrationBwLimitPerDeviceInKbps.ValueInt64(), 10) != "0" {
 			vtreeMigrationPolicy.BwLimitPerDeviceInKbps = strconv.FormatInt(plan.VtreeMigrationBwLimitPerDeviceInKbps.ValueInt64(), 10)
 		}
-		err := pd.SetVTreeMigrationIOPriorityPolicy(sp, vtreeMigrationPolicy)
+		err := helper.RunWithContext(ctx, func() error {
+			return pd.SetVTreeMigrationIOPriorityPolicy(sp, vtreeMigrationPolicy)
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Could not set Vtree migration Io priority policy  to %s", plan.VtreeMigrationIoPriorityPolicy.String()),
@@ -366,7 +395,9 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 
 	// set rebuild enabled
 	if !plan.RebuildEnabled.IsUnknown() && !plan.RebuildEnabled.IsNull() {
-		err := pd.SetRebuildEnabled(sp, plan.RebuildEnabled.String())
+		err := helper.RunWithContext(ctx, func() error {
+			return pd.SetRebuildEnabled(sp, plan.RebuildEnabled.String())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Could not set rebuild enabled to %s", plan.RebuildEnabled.String()),
@@ -377,7 +408,9 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 
 	// set rebuild rebalance parallelism
 	if !plan.RebuildRebalanceParallelism.IsUnknown() && !plan.RebuildRebalanceParallelism.IsNull() {
-		err := pd.SetRebuildRebalanceParallelismParam(sp, plan.RebuildRebalanceParallelism.String())
+		err := helper.RunWithContext(ctx, func() error {
+			return pd.SetRebuildRebalanceParallelismParam(sp, plan.RebuildRebalanceParallelism.String())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Could not set rebuild rebalance parallelism to %s", plan.RebuildRebalanceParallelism.String()),
@@ -388,7 +421,9 @@ func (r *storagepoolResource) Create(ctx context.Context, req resource.CreateReq
 
 	// set the fragmentation
 	if !plan.Fragmentation.IsUnknown() {
-		err := pd.Fragmentation(sp, plan.Fragmentation.ValueBool())
+		err := helper.RunWithContext(ctx, func() error {
+			return pd.Fragmentation(sp, plan.Fragmentation.ValueBool())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Could not set fragmentation to %s", plan.Fragmentation.String()),
@@ -427,15 +462,23 @@ func (r *storagepoolResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	system, err := helper.GetFirstSystem(r.client)
+	system, err := helper.GetSystem(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error in getting system instance on the PowerFlex cluster", err.Error(),
 		)
 		return
 	}
+	// system ID is not known when storage pool is imported
+	state.SystemID = types.StringValue(system.System.ID)
 
 	spr, err := system.GetStoragePoolByID(state.ID.ValueString())
+	// remove the storage pool from the state when it has been deleted outside of terraform
+	if helper.IsNotFoundError(err) {
+		tflog.Warn(ctx, "[POWERFLEX] storagepool "+state.ID.ValueString()+" not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Could not get storagepool by ID %s", state.ID.ValueString()),
@@ -485,7 +528,16 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	pd, err := helper.GetNewProtectionDomainEx(r.client, plan.ProtectionDomainID.ValueString(), plan.ProtectionDomainName.ValueString(), "")
+	// bound the operation by the update timeout of the resource
+	updateTimeout, diags := helper.GetUpdateTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	pd, err := helper.GetNewProtectionDomainEx(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), plan.ProtectionDomainID.ValueString(), plan.ProtectionDomainName.ValueString(), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting Protection Domain",
@@ -503,7 +555,10 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if plan.Name.ValueString() != state.Name.ValueString() {
-		_, err := pd.ModifyStoragePoolName(state.ID.ValueString(), plan.Name.ValueString())
+		err := helper.RunWithContext(ctx, func() error {
+			_, err := pd.ModifyStoragePoolName(state.ID.ValueString(), plan.Name.ValueString())
+			return err
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating name of Storagepool", err.Error(),
@@ -512,7 +567,10 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if plan.MediaType.ValueString() != state.MediaType.ValueString() {
-		_, err := pd.ModifyStoragePoolMedia(state.ID.ValueString(), plan.MediaType.ValueString())
+		err := helper.RunWithContext(ctx, func() error {
+			_, err := pd.ModifyStoragePoolMedia(state.ID.ValueString(), plan.MediaType.ValueString())
+			return err
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating media type of Storagepool", err.Error(),
@@ -523,7 +581,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 	rm := goscaleio.NewStoragePoolEx(r.client, spResponse)
 
 	if !plan.UseRmcache.IsUnknown() && !state.UseRmcache.Equal(plan.UseRmcache) {
-		err := rm.ModifyRMCache(plan.UseRmcache.String())
+		err := helper.RunWithContext(ctx, func() error {
+			return rm.ModifyRMCache(plan.UseRmcache.String())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating rm_cache of Storagepool", err.Error(),
@@ -533,16 +593,24 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !plan.UseRfcache.IsUnknown() && !state.UseRfcache.Equal(plan.UseRfcache) {
 		if plan.UseRfcache.String() == "true" {
-			_, err1 = pd.EnableRFCache(spResponse.ID)
+			err1 = helper.RunWithContext(ctx, func() error {
+				_, err := pd.EnableRFCache(spResponse.ID)
+				return err
+			})
 
 		} else {
-			_, err1 = pd.DisableRFCache(spResponse.ID)
+			err1 = helper.RunWithContext(ctx, func() error {
+				_, err := pd.DisableRFCache(spResponse.ID)
+				return err
+			})
 		}
 	}
 
 	if !plan.ZeroPaddingEnabled.IsUnknown() &&
 		!state.ZeroPaddingEnabled.Equal(plan.ZeroPaddingEnabled) {
-		errZeroPaddingEnabled := pd.EnableOrDisableZeroPadding(spResponse.ID, plan.ZeroPaddingEnabled.String())
+		errZeroPaddingEnabled := helper.RunWithContext(ctx, func() error {
+			return pd.EnableOrDisableZeroPadding(spResponse.ID, plan.ZeroPaddingEnabled.String())
+		})
 		if errZeroPaddingEnabled != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating ZeroPadding settings of Storagepool", errZeroPaddingEnabled.Error(),
@@ -552,7 +620,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !plan.ReplicationJournalCapacity.IsUnknown() &&
 		!state.ReplicationJournalCapacity.Equal(plan.ReplicationJournalCapacity) {
-		errReplicationJournalCapacity := pd.SetReplicationJournalCapacity(spResponse.ID, strconv.FormatInt(plan.ReplicationJournalCapacity.ValueInt64(), 10))
+		errReplicationJournalCapacity := helper.RunWithContext(ctx, func() error {
+			return pd.SetReplicationJournalCapacity(spResponse.ID, strconv.FormatInt(plan.ReplicationJournalCapacity.ValueInt64(), 10))
+		})
 		if errReplicationJournalCapacity != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating ReplicationJournalCapacity of Storagepool", errReplicationJournalCapacity.Error(),
@@ -561,7 +631,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if capacityAlertThresholdParam, ok := helper.IsCritcalAlert(plan, state); !ok {
-		errSetCapacityAlertThreshold := pd.SetCapacityAlertThreshold(spResponse.ID, capacityAlertThresholdParam)
+		errSetCapacityAlertThreshold := helper.RunWithContext(ctx, func() error {
+			return pd.SetCapacityAlertThreshold(spResponse.ID, capacityAlertThresholdParam)
+		})
 		if errSetCapacityAlertThreshold != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating Capacity Alert Thresholds of Storagepool", errSetCapacityAlertThreshold.Error(),
@@ -570,7 +642,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if protectedMaintenanceModeParam, ok := helper.IsProtectedMaintenance(plan, state); !ok {
-		errProtectedMaintenanceModeIoPriorityPolicy := pd.SetProtectedMaintenanceModeIoPriorityPolicy(spResponse.ID, protectedMaintenanceModeParam)
+		errProtectedMaintenanceModeIoPriorityPolicy := helper.RunWithContext(ctx, func() error {
+			return pd.SetProtectedMaintenanceModeIoPriorityPolicy(spResponse.ID, protectedMaintenanceModeParam)
+		})
 		if errProtectedMaintenanceModeIoPriorityPolicy != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating Protect Maintenance Policy/NumOfConcurrentIosPerDevice/BwLimitPerDeviceInKbps of Storagepool", errProtectedMaintenanceModeIoPriorityPolicy.Error(),
@@ -580,7 +654,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !plan.RebalanceEnabled.IsUnknown() &&
 		!state.RebalanceEnabled.Equal(plan.RebalanceEnabled) {
-		err := pd.SetRebalanceEnabled(spResponse.ID, plan.RebalanceEnabled.String())
+		err := helper.RunWithContext(ctx, func() error {
+			return pd.SetRebalanceEnabled(spResponse.ID, plan.RebalanceEnabled.String())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Error while updating rebalance enabled to %s", plan.RebalanceEnabled.String()),
@@ -590,7 +666,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if rebalanceIoPriorityPolicy, ok := helper.IsRebalance(plan, state); !ok {
-		errRebalanceIoPrioritypolicy := pd.SetRebalanceIoPriorityPolicy(spResponse.ID, rebalanceIoPriorityPolicy)
+		errRebalanceIoPrioritypolicy := helper.RunWithContext(ctx, func() error {
+			return pd.SetRebalanceIoPriorityPolicy(spResponse.ID, rebalanceIoPriorityPolicy)
+		})
 		if errRebalanceIoPrioritypolicy != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating Rebalance Policy/NumOfConcurrentIosPerDevice/BwLimitPerDeviceInKbps of Storagepool", errRebalanceIoPrioritypolicy.Error(),
@@ -599,7 +677,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if vtreeMigrationPolicy, ok := helper.IsVtreeMigration(plan, state); !ok {
-		errVtreeMigrationIoPriorityPolicy := pd.SetVTreeMigrationIOPriorityPolicy(spResponse.ID, vtreeMigrationPolicy)
+		errVtreeMigrationIoPriorityPolicy := helper.RunWithContext(ctx, func() error {
+			return pd.SetVTreeMigrationIOPriorityPolicy(spResponse.ID, vtreeMigrationPolicy)
+		})
 		if errVtreeMigrationIoPriorityPolicy != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating Vtree Migration Policy/NumOfConcurrentIosPerDevice/BwLimitPerDeviceInKbps of Storagepool", errVtreeMigrationIoPriorityPolicy.Error(),
@@ -609,7 +689,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !plan.SparePercentage.IsUnknown() &&
 		!state.SparePercentage.Equal(plan.SparePercentage) {
-		errSparePercentage := pd.SetSparePercentage(spResponse.ID, strconv.FormatInt(plan.SparePercentage.ValueInt64(), 10))
+		errSparePercentage := helper.RunWithContext(ctx, func() error {
+			return pd.SetSparePercentage(spResponse.ID, strconv.FormatInt(plan.SparePercentage.ValueInt64(), 10))
+		})
 		if errSparePercentage != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating SparePercentage of Storagepool", errSparePercentage.Error(),
@@ -619,7 +701,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !plan.RmCacheWriteHandlingMode.IsUnknown() &&
 		!state.RmCacheWriteHandlingMode.Equal(plan.RmCacheWriteHandlingMode) {
-		errRmCacheWriteHandlingMode := pd.SetRMcacheWriteHandlingMode(spResponse.ID, plan.RmCacheWriteHandlingMode.ValueString())
+		errRmCacheWriteHandlingMode := helper.RunWithContext(ctx, func() error {
+			return pd.SetRMcacheWriteHandlingMode(spResponse.ID, plan.RmCacheWriteHandlingMode.ValueString())
+		})
 		if errRmCacheWriteHandlingMode != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating RmCacheWriteHandlingMode of Storagepool", errRmCacheWriteHandlingMode.Error(),
@@ -629,7 +713,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !plan.RebuildEnabled.IsUnknown() &&
 		!state.RebuildEnabled.Equal(plan.RebuildEnabled) {
-		errRebuildEnabled := pd.SetRebuildEnabled(spResponse.ID, plan.RebuildEnabled.String())
+		errRebuildEnabled := helper.RunWithContext(ctx, func() error {
+			return pd.SetRebuildEnabled(spResponse.ID, plan.RebuildEnabled.String())
+		})
 		if errRebuildEnabled != nil {
 			resp.Diagnostics.AddError(
 				"Error while updating RebuildEnabled of Storagepool", errRebuildEnabled.Error(),
@@ -639,7 +725,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !plan.RebuildRebalanceParallelism.IsUnknown() &&
 		!state.RebuildRebalanceParallelism.Equal(plan.RebuildRebalanceParallelism) {
-		errRebuildRebalanceParallelism := pd.SetRebuildRebalanceParallelismParam(spResponse.ID, strconv.FormatInt(plan.RebuildRebalanceParallelism.ValueInt64(), 10))
+		errRebuildRebalanceParallelism := helper.RunWithContext(ctx, func() error {
+			return pd.SetRebuildRebalanceParallelismParam(spResponse.ID, strconv.FormatInt(plan.RebuildRebalanceParallelism.ValueInt64(), 10))
+		})
 		if errRebuildRebalanceParallelism != nil {
 			resp.Diagnostics.AddError(
 				"Error updating RebuildRebalanceParallelism settings of Storagepool", errRebuildRebalanceParallelism.Error(),
@@ -649,7 +737,9 @@ func (r *storagepoolResource) Update(ctx context.Context, req resource.UpdateReq
 
 	if !plan.Fragmentation.IsUnknown() &&
 		!state.Fragmentation.Equal(plan.Fragmentation) {
-		errFragmentation := pd.Fragmentation(spResponse.ID, plan.Fragmentation.ValueBool())
+		errFragmentation := helper.RunWithContext(ctx, func() error {
+			return pd.Fragmentation(spResponse.ID, plan.Fragmentation.ValueBool())
+		})
 		if errFragmentation != nil {
 			resp.Diagnostics.AddError(
 				"Error updating Fragmentation settings of Storagepool", errFragmentation.Error(),
@@ -692,7 +782,16 @@ func (r *storagepoolResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	pd, err := helper.GetNewProtectionDomainEx(r.client, state.ProtectionDomainID.ValueString(), state.ProtectionDomainName.ValueString(), "")
+	// bound the operation by the delete timeout of the resource
+	deleteTimeout, diags := helper.GetDeleteTimeout(state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	pd, err := helper.GetNewProtectionDomainEx(r.client, helper.SystemIDOrDefault(state.SystemID, r.systemID), state.ProtectionDomainID.ValueString(), state.ProtectionDomainName.ValueString(), "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting Protection Domain",
@@ -701,7 +800,9 @@ func (r *storagepoolResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err = pd.DeleteStoragePool(state.Name.ValueString())
+	err = helper.RunWithContext(ctx, func() error {
+		return pd.DeleteStoragePool(state.Name.ValueString())
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Storagepool",
@@ -22,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
@@ -253,5 +255,25 @@ var StoragepoolReourceSchema schema.Schema = schema.Schema{
 			Optional:            true,
 			Computed:            true,
 		},
+		"system_id": schema.StringAttribute{
+			Description: "ID of the PowerFlex system on which the storage pool will be created." +
+				" Defaults to the system configured on the provider." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "ID of the PowerFlex system on which the storage pool will be created." +
+				" Defaults to the system configured on the provider." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"timeouts": TimeoutsBlock,
 	},
 }
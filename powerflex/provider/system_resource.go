@@ -0,0 +1,304 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &systemResource{}
+	_ resource.ResourceWithConfigure   = &systemResource{}
+	_ resource.ResourceWithImportState = &systemResource{}
+)
+
+// NewSystemResource is a helper function to simplify the provider implementation.
+func NewSystemResource() resource.Resource {
+	return &systemResource{}
+}
+
+// systemResource is the resource implementation.
+// The system always exists, the resource only manages its settings.
+type systemResource struct {
+	client   *goscaleio.Client
+	systemID string
+}
+
+// Metadata returns the resource type name.
+func (r *systemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system"
+}
+
+// Schema defines the schema for the resource.
+func (r *systemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = SystemResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *systemResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
+}
+
+// Create applies the configured settings to the system and sets the initial Terraform state.
+func (r *systemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SystemResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	system, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	// the state is null, no SDC is managed yet
+	resp.Diagnostics.Append(r.updateSystem(ctx, system.System.ID, &plan, types.SetNull(plan.SdcApprovedIps.ElementType(ctx)))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *systemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SystemResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := client.GetSystemSettings(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		// remove the system from the state when it is not managed by the gateway anymore
+		if helper.IsNotFoundError(err) {
+			tflog.Warn(ctx, "[POWERFLEX] system "+state.ID.ValueString()+" not found, removing it from the state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error getting system",
+			"Could not get system, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	sdcs, err := client.GetSdcApprovedIps(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting approved IPs of the SDCs",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// only the SDCs of the state are managed, none after an import
+	managed, dgs := helper.GetPlannedSdcApprovedIps(ctx, state.SdcApprovedIps)
+	resp.Diagnostics.Append(dgs...)
+	resp.Diagnostics.Append(helper.UpdateSystemState(ctx, settings, sdcs, managed, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *systemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.SystemResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var state models.SystemResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateSystem(ctx, state.ID.ValueString(), &plan, state.SdcApprovedIps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the resource from the Terraform state, the settings of the system are left unchanged.
+func (r *systemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.SystemResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "[POWERFLEX] removing system "+state.ID.ValueString()+" from the state, its settings are not changed")
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the system by its ID.
+func (r *systemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateSystem applies the configured settings which differ from the ones of the system, then saves them in the plan.
+// The approved IPs of the SDCs are set before the restricted SDC mode, so that the SDCs stay connected when it is enabled.
+// Only the SDCs listed in the plan or in the previous approved IPs of the state are managed, the approved IPs
+// of the other SDCs, like the ones approved with powerflex_sdc_approval, are left unchanged.
+func (r *systemResource) updateSystem(ctx context.Context, id string, plan *models.SystemResourceModel, previous types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	settings, err := client.GetSystemSettings(ctx, r.client, id)
+	if err != nil {
+		diags.AddError(
+			"Error getting system",
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	managed, dgs := helper.GetPlannedSdcApprovedIps(ctx, previous)
+	diags.Append(dgs...)
+	if diags.HasError() {
+		return diags
+	}
+	if !plan.SdcApprovedIps.IsUnknown() {
+		planned, dgs := helper.GetPlannedSdcApprovedIps(ctx, plan.SdcApprovedIps)
+		diags.Append(dgs...)
+		if diags.HasError() {
+			return diags
+		}
+		previousSdcs := managed
+		managed = planned
+		sdcs, err := client.GetSdcApprovedIps(ctx, r.client, id)
+		if err != nil {
+			diags.AddError(
+				"Error getting approved IPs of the SDCs",
+				"unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+		current := map[string][]string{}
+		for _, sdc := range sdcs {
+			current[sdc.ID] = sdc.SdcApprovedIps
+		}
+		// the SDCs which are not listed anymore lose their approved IPs
+		toSet := map[string][]string{}
+		for sdcID := range previousSdcs {
+			if _, ok := planned[sdcID]; !ok && len(current[sdcID]) > 0 {
+				toSet[sdcID] = []string{}
+			}
+		}
+		for sdcID, ips := range planned {
+			toSet[sdcID] = ips
+		}
+		for sdcID, ips := range toSet {
+			if helper.SameIPs(ips, current[sdcID]) {
+				continue
+			}
+			if err := client.SetSdcApprovedIps(ctx, r.client, id, sdcID, ips); err != nil {
+				diags.AddError(
+					"Error setting approved IPs of SDC "+sdcID,
+					"unexpected error: "+err.Error(),
+				)
+				return diags
+			}
+			tflog.Info(ctx, "[POWERFLEX] approved IPs of SDC "+sdcID+" set")
+		}
+	}
+
+	if mode := plan.RestrictedSdcMode; !mode.IsUnknown() && mode.ValueString() != settings.RestrictedSdcMode {
+		if err := client.SetRestrictedSdcMode(ctx, r.client, id, mode.ValueString()); err != nil {
+			diags.AddError(
+				"Error setting restricted SDC mode",
+				"unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	high, critical := settings.CapacityAlertHighThresholdPercent, settings.CapacityAlertCriticalThresholdPercent
+	if !plan.CapacityAlertHighThreshold.IsUnknown() {
+		high = int(plan.CapacityAlertHighThreshold.ValueInt64())
+	}
+	if !plan.CapacityAlertCriticalThreshold.IsUnknown() {
+		critical = int(plan.CapacityAlertCriticalThreshold.ValueInt64())
+	}
+	if high != settings.CapacityAlertHighThresholdPercent || critical != settings.CapacityAlertCriticalThresholdPercent {
+		if err := client.SetSystemCapacityAlertThresholds(ctx, r.client, id, high, critical); err != nil {
+			diags.AddError(
+				"Error setting capacity alert thresholds",
+				"unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	if allowed := plan.CliPasswordAllowed; !allowed.IsUnknown() && allowed.ValueBool() != settings.CliPasswordAllowed {
+		if err := client.SetCliPasswordAllowed(ctx, r.client, id, allowed.ValueBool()); err != nil {
+			diags.AddError(
+				"Error setting CLI password rule",
+				"unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	settings, err = client.GetSystemSettings(ctx, r.client, id)
+	if err != nil {
+		diags.AddError(
+			"Error getting system after update",
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	sdcs, err := client.GetSdcApprovedIps(ctx, r.client, id)
+	if err != nil {
+		diags.AddError(
+			"Error getting approved IPs of the SDCs after update",
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	diags.Append(helper.UpdateSystemState(ctx, settings, sdcs, managed, plan)...)
+	return diags
+}
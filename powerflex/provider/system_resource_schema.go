@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SystemResourceSchema variable to define schema for the system resource
+var SystemResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource can be used to manage the system-wide settings of a PowerFlex array." +
+		" The settings which are not configured are left unchanged.",
+	MarkdownDescription: "This resource can be used to manage the system-wide settings of a PowerFlex array." +
+		" The settings which are not configured are left unchanged.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the system.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the system.",
+			Computed:            true,
+			MarkdownDescription: "The name of the system.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"restricted_sdc_mode": schema.StringAttribute{
+			Description: "The restricted SDC mode of the system, which SDCs have to be approved to connect." +
+				" Accepted values are 'None', 'Guid' and 'ApprovedIp'.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The restricted SDC mode of the system, which SDCs have to be approved to connect." +
+				" Accepted values are `None`, `Guid` and `ApprovedIp`.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					client.RestrictedSdcModeNone,
+					client.RestrictedSdcModeGUID,
+					client.RestrictedSdcModeApprovedIP,
+				),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sdc_approved_ips": schema.SetNestedAttribute{
+			Description: "The IPs the SDCs are approved to connect from in the 'ApprovedIp' restricted SDC mode." +
+				" The approved IPs of the SDCs removed from the list are removed, the approved IPs of the SDCs which were never listed are left unchanged.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The IPs the SDCs are approved to connect from in the `ApprovedIp` restricted SDC mode." +
+				" The approved IPs of the SDCs removed from the list are removed, the approved IPs of the SDCs which were never listed are left unchanged.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"sdc_id": schema.StringAttribute{
+						Description:         "ID of the SDC.",
+						Required:            true,
+						MarkdownDescription: "ID of the SDC.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"ips": schema.SetAttribute{
+						Description:         "IPs the SDC is approved to connect from.",
+						Required:            true,
+						MarkdownDescription: "IPs the SDC is approved to connect from.",
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+					},
+				},
+			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"capacity_alert_high_threshold": schema.Int64Attribute{
+			Description:         "The capacity usage of the system in percent above which a high capacity alert is raised. It must be lower than the critical threshold.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The capacity usage of the system in percent above which a high capacity alert is raised. It must be lower than the critical threshold.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 99),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"capacity_alert_critical_threshold": schema.Int64Attribute{
+			Description:         "The capacity usage of the system in percent above which a critical capacity alert is raised.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The capacity usage of the system in percent above which a critical capacity alert is raised.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 100),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"cli_password_allowed": schema.BoolAttribute{
+			Description:         "Whether passwords can be given on the command line of the CLI.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Whether passwords can be given on the command line of the CLI.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
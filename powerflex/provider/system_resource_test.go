@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var createSystemPosTest = `
+resource "powerflex_system" "system" {
+	capacity_alert_high_threshold = 70
+	capacity_alert_critical_threshold = 85
+}
+`
+
+var updateSystemPosTest = `
+resource "powerflex_system" "system" {
+	restricted_sdc_mode = "ApprovedIp"
+	sdc_approved_ips = [
+		{
+			sdc_id = "` + SDCMappingResourceID2 + `"
+			ips = ["` + SdsResourceTestData.SdcIP + `"]
+		},
+	]
+	capacity_alert_high_threshold = 70
+	capacity_alert_critical_threshold = 85
+	cli_password_allowed = false
+}
+`
+
+var revertSystemPosTest = `
+resource "powerflex_system" "system" {
+	restricted_sdc_mode = "None"
+	sdc_approved_ips = []
+	capacity_alert_high_threshold = 80
+	capacity_alert_critical_threshold = 90
+	cli_password_allowed = true
+}
+`
+
+var updateSystemInvalidThresholdsTest = `
+resource "powerflex_system" "system" {
+	capacity_alert_high_threshold = 95
+	capacity_alert_critical_threshold = 90
+}
+`
+
+var updateSystemInvalidModeTest = `
+resource "powerflex_system" "system" {
+	restricted_sdc_mode = "Ip"
+}
+`
+
+func TestAccSystemResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + updateSystemInvalidModeTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createSystemPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerflex_system.system", "id"),
+					resource.TestCheckResourceAttrSet("powerflex_system.system", "name"),
+					resource.TestCheckResourceAttrSet("powerflex_system.system", "restricted_sdc_mode"),
+					resource.TestCheckResourceAttr("powerflex_system.system", "capacity_alert_high_threshold", "70"),
+					resource.TestCheckResourceAttr("powerflex_system.system", "capacity_alert_critical_threshold", "85"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_system.system",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      ProviderConfigForTesting + updateSystemInvalidThresholdsTest,
+				ExpectError: regexp.MustCompile(`.*Error setting capacity alert thresholds*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + updateSystemPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_system.system", "restricted_sdc_mode", "ApprovedIp"),
+					resource.TestCheckResourceAttr("powerflex_system.system", "sdc_approved_ips.#", "1"),
+					resource.TestCheckResourceAttr("powerflex_system.system", "sdc_approved_ips.0.sdc_id", SDCMappingResourceID2),
+					resource.TestCheckResourceAttr("powerflex_system.system", "sdc_approved_ips.0.ips.#", "1"),
+					resource.TestCheckResourceAttr("powerflex_system.system", "cli_password_allowed", "false"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + revertSystemPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_system.system", "restricted_sdc_mode", "None"),
+					resource.TestCheckResourceAttr("powerflex_system.system", "sdc_approved_ips.#", "0"),
+					resource.TestCheckResourceAttr("powerflex_system.system", "cli_password_allowed", "true"),
+				),
+			},
+		},
+	})
+}
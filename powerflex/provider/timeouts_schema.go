@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// TimeoutsBlock variable to define the timeouts block shared by the resources with long-running operations
+var TimeoutsBlock schema.SingleNestedBlock = schema.SingleNestedBlock{
+	Description:         "Timeouts of the create, update and delete operations of the resource.",
+	MarkdownDescription: "Timeouts of the create, update and delete operations of the resource.",
+	Attributes: map[string]schema.Attribute{
+		"create": schema.StringAttribute{
+			Description:         "Timeout of the create operation, like '30s' or '1h'. Default value is '20m'.",
+			MarkdownDescription: "Timeout of the create operation, like `30s` or `1h`. Default value is `20m`.",
+			Optional:            true,
+			Validators: []validator.String{
+				helper.DurationValidator{},
+			},
+		},
+		"update": schema.StringAttribute{
+			Description:         "Timeout of the update operation, like '30s' or '1h'. Default value is '20m'.",
+			MarkdownDescription: "Timeout of the update operation, like `30s` or `1h`. Default value is `20m`.",
+			Optional:            true,
+			Validators: []validator.String{
+				helper.DurationValidator{},
+			},
+		},
+		"delete": schema.StringAttribute{
+			Description:         "Timeout of the delete operation, like '30s' or '1h'. Default value is '20m'.",
+			MarkdownDescription: "Timeout of the delete operation, like `30s` or `1h`. Default value is `20m`.",
+			Optional:            true,
+			Validators: []validator.String{
+				helper.DurationValidator{},
+			},
+		},
+	},
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &userDataSource{}
+	_ datasource.DataSourceWithConfigure = &userDataSource{}
+)
+
+// UserDataSource returns the datasource for user
+func UserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+type userDataSource struct {
+	client   *goscaleio.Client
+	systemID string
+}
+
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = UserDataSourceSchema
+}
+
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	d.client = p.client
+	d.systemID = p.systemID
+}
+
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.UserDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "[POWERFLEX] userDataSourceModel"+helper.PrettyJSON((state)))
+
+	system, err := helper.GetSystem(d.client, d.systemID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	users, err := system.GetUser()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Powerflex Users",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Users = []models.UserModel{}
+	for i := range users {
+		if !state.ID.IsNull() && users[i].ID != state.ID.ValueString() ||
+			!state.Name.IsNull() && users[i].Name != state.Name.ValueString() {
+			continue
+		}
+		state.Users = append(state.Users, helper.GetUserState(&users[i]))
+	}
+
+	if !state.ID.IsNull() || !state.Name.IsNull() {
+		if len(state.Users) == 0 {
+			filter := "id " + state.ID.ValueString()
+			if !state.Name.IsNull() {
+				filter = "name " + state.Name.ValueString()
+			}
+			resp.Diagnostics.AddError(
+				"Unable to Read Powerflex User",
+				"couldn't find user with "+filter,
+			)
+			return
+		}
+		// this is required for acceptance testing
+		state.ID = state.Users[0].ID
+	} else {
+		// this is required for acceptance testing
+		state.ID = types.StringValue("DummyID")
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
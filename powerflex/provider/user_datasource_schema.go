@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// UserDataSourceSchema defines the schema for user datasource
+var UserDataSourceSchema schema.Schema = schema.Schema{
+	Description:         "This datasource can be used to fetch information related to users from a PowerFlex array.",
+	MarkdownDescription: "This datasource can be used to fetch information related to users from a PowerFlex array.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Unique identifier of the user to fetch." +
+				" Conflicts with 'name'.",
+			MarkdownDescription: "Unique identifier of the user to fetch." +
+				" Conflicts with `name`.",
+			Optional: true,
+			Computed: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the user to fetch." +
+				" Conflicts with 'id'.",
+			MarkdownDescription: "Name of the user to fetch." +
+				" Conflicts with `id`.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"users": schema.ListNestedAttribute{
+			Description:         "List of users fetched.",
+			MarkdownDescription: "List of users fetched.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "Unique identifier of the user.",
+						MarkdownDescription: "Unique identifier of the user.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Name of the user.",
+						MarkdownDescription: "Name of the user.",
+						Computed:            true,
+					},
+					"role": schema.StringAttribute{
+						Description:         "Role of the user.",
+						MarkdownDescription: "Role of the user.",
+						Computed:            true,
+					},
+					"system_id": schema.StringAttribute{
+						Description:         "ID of the PowerFlex system of the user.",
+						MarkdownDescription: "ID of the PowerFlex system of the user.",
+						Computed:            true,
+					},
+					"password_change_required": schema.BoolAttribute{
+						Description:         "Whether the user has to change the password at the next login.",
+						MarkdownDescription: "Whether the user has to change the password at the next login.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var userDataSourceConfig1 = createUserPosTest + `
+data "powerflex_user" "user1" {
+	name = resource.powerflex_user.user.name
+}
+`
+
+var userDataSourceConfig2 = createUserPosTest + `
+data "powerflex_user" "user2" {
+	id = resource.powerflex_user.user.id
+}
+`
+
+var userDataSourceConfig3 = createUserPosTest + `
+data "powerflex_user" "user3" {
+	depends_on = [resource.powerflex_user.user]
+}
+`
+
+var userDataSourceConfig4 = `
+data "powerflex_user" "user4" {
+	name = "invalid-user"
+}
+`
+
+func TestAccUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfigForTesting + userDataSourceConfig1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_user.user1", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.powerflex_user.user1", "users.0.name", "tfacc_user"),
+					resource.TestCheckResourceAttr("data.powerflex_user.user1", "users.0.role", "Monitor"),
+					resource.TestCheckResourceAttr("data.powerflex_user.user1", "users.0.password_change_required", "true"),
+					resource.TestCheckResourceAttrPair("data.powerflex_user.user1", "id", "powerflex_user.user", "id"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + userDataSourceConfig2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_user.user2", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.powerflex_user.user2", "users.0.system_id", "powerflex_user.user", "system_id"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + userDataSourceConfig3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerflex_user.user3", "users.1.id"),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + userDataSourceConfig4,
+				ExpectError: regexp.MustCompile(`.*couldn't find user with name invalid-user*.`),
+			},
+		},
+	})
+}
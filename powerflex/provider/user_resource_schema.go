@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// UserResourceSchema variable to define schema for the user resource
+var UserResourceSchema schema.Schema = schema.Schema{
+	Description:         "This resource can be used to manage users on a PowerFlex array.",
+	MarkdownDescription: "This resource can be used to manage users on a PowerFlex array.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the user.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the user.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the user. Cannot be updated.",
+			Required:            true,
+			MarkdownDescription: "The name of the user. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"role": schema.StringAttribute{
+			Description: "The role of the user." +
+				" Accepted values are 'Monitor', 'Configure', 'Administrator', 'Security', 'FrontendConfig', 'BackendConfig' and 'SuperUser'.",
+			Required: true,
+			MarkdownDescription: "The role of the user." +
+				" Accepted values are `Monitor`, `Configure`, `Administrator`, `Security`, `FrontendConfig`, `BackendConfig` and `SuperUser`.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(client.UserRoles...),
+			},
+		},
+		"password": schema.StringAttribute{
+			Description: "The initial password of the user." +
+				" Updating it resets the password of the user, who has to change it at the next login." +
+				" It is not read from PowerFlex, so it is not updated on the array after an import.",
+			Required:  true,
+			Sensitive: true,
+			MarkdownDescription: "The initial password of the user." +
+				" Updating it resets the password of the user, who has to change it at the next login." +
+				" It is not read from PowerFlex, so it is not updated on the array after an import.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"force_password_change": schema.BoolAttribute{
+			Description:         "Whether the user has to change the password at the next login, set by the creation and by each reset of the password.",
+			Computed:            true,
+			MarkdownDescription: "Whether the user has to change the password at the next login, set by the creation and by each reset of the password.",
+		},
+		"system_id": schema.StringAttribute{
+			Description:         "ID of the PowerFlex system of the user.",
+			Computed:            true,
+			MarkdownDescription: "ID of the PowerFlex system of the user.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
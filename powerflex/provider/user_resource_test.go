@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var createUserPosTest = `
+resource "powerflex_user" "user" {
+	name = "tfacc_user"
+	role = "Monitor"
+	password = "Password123!"
+}
+`
+
+var updateUserPosTest = `
+resource "powerflex_user" "user" {
+	name = "tfacc_user"
+	role = "Configure"
+	password = "Password456!"
+}
+`
+
+var createUserInvalidRoleTest = `
+resource "powerflex_user" "user-invalid" {
+	name = "tfacc_user_invalid"
+	role = "Reader"
+	password = "Password123!"
+}
+`
+
+func TestAccUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createUserInvalidRoleTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value Match*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createUserPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_user.user", "name", "tfacc_user"),
+					resource.TestCheckResourceAttr("powerflex_user.user", "role", "Monitor"),
+					resource.TestCheckResourceAttr("powerflex_user.user", "force_password_change", "true"),
+					resource.TestCheckResourceAttrSet("powerflex_user.user", "id"),
+					resource.TestCheckResourceAttrSet("powerflex_user.user", "system_id"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:            "powerflex_user.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: ProviderConfigForTesting + updateUserPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_user.user", "role", "Configure"),
+					resource.TestCheckResourceAttr("powerflex_user.user", "password", "Password456!"),
+					resource.TestCheckResourceAttr("powerflex_user.user", "force_password_change", "true"),
+				),
+			},
+		},
+	})
+}
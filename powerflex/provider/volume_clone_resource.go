@@ -0,0 +1,388 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	pftypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &volumeCloneResource{}
+	_ resource.ResourceWithConfigure   = &volumeCloneResource{}
+	_ resource.ResourceWithImportState = &volumeCloneResource{}
+	_ resource.ResourceWithModifyPlan  = &volumeCloneResource{}
+)
+
+// NewVolumeCloneResource is a helper function to simplify the provider implementation.
+func NewVolumeCloneResource() resource.Resource {
+	return &volumeCloneResource{}
+}
+
+// volumeCloneResource is the resource implementation.
+// A volume clone is a writable snapshot of a volume or of a snapshot, which joins the VTree of its source
+// and can be renamed, resized and mapped like any volume.
+type volumeCloneResource struct {
+	client   *goscaleio.Client
+	systemID string
+}
+
+// Metadata returns the resource type name.
+func (r *volumeCloneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_clone"
+}
+
+// Schema defines the schema for the resource.
+func (r *volumeCloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = VolumeCloneResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *volumeCloneResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
+}
+
+// ModifyPlan computes the size in KB of the clone and validates it before anything is created or resized.
+func (r *volumeCloneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.VolumeCloneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	// the state is null when the clone is created
+	var state models.VolumeCloneResourceModel
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Size.IsUnknown() || plan.CapacityUnit.IsUnknown() {
+		return
+	}
+	plan.SizeInKb = types.Int64Value(helper.ConvertToKB(plan.CapacityUnit.ValueString(), plan.Size.ValueInt64()))
+	diags = resp.Plan.SetAttribute(ctx, path.Root("size_in_kb"), plan.SizeInKb)
+	resp.Diagnostics.Append(diags...)
+	if plan.SizeInKb.Equal(state.SizeInKb) {
+		return
+	}
+
+	// the volume being resized: the clone itself, or its source when it is created.
+	// The provider is not configured when the configuration is only validated.
+	var volume *pftypes.Volume
+	if r.client != nil {
+		volumeID := state.ID
+		if state.ID.IsNull() {
+			volumeID = plan.SourceVolumeID
+			if volumeID.IsNull() {
+				volumeID = plan.SourceSnapshotID
+			}
+		}
+		// a source that cannot be found is reported when the clone is created
+		if !volumeID.IsNull() && !volumeID.IsUnknown() && volumeID.ValueString() != "" {
+			if vol, err := r.getVolume(volumeID.ValueString()); err == nil {
+				volume = vol
+			}
+		}
+	}
+	currentSizeInKb := state.SizeInKb.ValueInt64()
+	if state.ID.IsNull() && volume != nil {
+		currentSizeInKb = int64(volume.SizeInKb)
+	}
+	diags = helper.CheckVolumeResize(r.client, r.systemID, volume, plan.SizeInKb.ValueInt64(), currentSizeInKb)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *volumeCloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.VolumeCloneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, diags := r.getSource(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sr, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+
+	// the clone is a snapshot of its source, created writable and with its own name
+	snapResps, err := sr.CreateSnapshotConsistencyGroup(&pftypes.SnapshotVolumesParam{
+		SnapshotDefs: []*pftypes.SnapshotDef{
+			{
+				VolumeID:     source.ID,
+				SnapshotName: plan.Name.ValueString(),
+			},
+		},
+		AccessMode: plan.AccessMode.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating volume clone",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	cloneID := snapResps.VolumeIDList[0]
+	tflog.Info(ctx, "[POWERFLEX] volume clone "+cloneID+" of "+source.ID+" created")
+
+	clone, err := r.getVolume(cloneID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting volume clone after creation",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// the clone has the size of its source until it is resized,
+	// the state is saved even when the clone could not be resized so that the clone is not lost
+	if !plan.SizeInKb.IsUnknown() && plan.SizeInKb.ValueInt64() != int64(clone.SizeInKb) {
+		cloneResource := goscaleio.NewVolume(r.client)
+		cloneResource.Volume = clone
+		if err := cloneResource.SetVolumeSize(strconv.FormatInt(plan.SizeInKb.ValueInt64()/helper.GiKB, 10)); err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting the size of volume clone "+cloneID,
+				"unexpected error: "+err.Error(),
+			)
+		} else if clone, err = r.getVolume(cloneID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting volume clone after resize",
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	helper.UpdateVolumeCloneState(clone, source, &plan)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *volumeCloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.VolumeCloneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clone, err := r.getVolume(state.ID.ValueString())
+	if err != nil {
+		// remove the clone from the state when it has been deleted outside of terraform
+		if helper.IsNotFoundError(err) {
+			tflog.Warn(ctx, "[POWERFLEX] volume clone "+state.ID.ValueString()+" not found, removing it from the state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error getting volume clone",
+			"Could not get volume clone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// the source of the clone is not known when the clone is imported
+	var source *pftypes.Volume
+	if state.SourceVolumeID.IsNull() && state.SourceSnapshotID.IsNull() {
+		source, err = r.getVolume(clone.AncestorVolumeID)
+		if err != nil && !helper.IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error getting source of volume clone",
+				"Could not get volume "+clone.AncestorVolumeID+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	helper.UpdateVolumeCloneState(clone, source, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// The clone is renamed, resized and its access mode is set, its source requires a replacement.
+func (r *volumeCloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.VolumeCloneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var state models.VolumeCloneResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vol, err := r.getVolume(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting volume clone",
+			"Could not get volume clone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	clone := goscaleio.NewVolume(r.client)
+	clone.Volume = vol
+
+	if plan.Name.ValueString() != state.Name.ValueString() {
+		if err := clone.SetVolumeName(plan.Name.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error renaming volume clone",
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.SizeInKb.IsUnknown() && plan.SizeInKb.ValueInt64() != state.SizeInKb.ValueInt64() {
+		if err := clone.SetVolumeSize(strconv.FormatInt(plan.SizeInKb.ValueInt64()/helper.GiKB, 10)); err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting the size of volume clone",
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.AccessMode.ValueString() != state.AccessMode.ValueString() {
+		if err := clone.SetVolumeAccessModeLimit(plan.AccessMode.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error setting the access mode of volume clone",
+				"unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	vol, err = r.getVolume(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting volume clone after update",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	helper.UpdateVolumeCloneState(vol, nil, &plan)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *volumeCloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.VolumeCloneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vol, err := r.getVolume(state.ID.ValueString())
+	if err == nil {
+		clone := goscaleio.NewVolume(r.client)
+		clone.Volume = vol
+		err = clone.RemoveVolume("ONLY_ME")
+	}
+	if err != nil && !helper.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error removing volume clone",
+			"Couldn't remove volume clone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the volume clone by its ID.
+func (r *volumeCloneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getSource returns the volume or the snapshot to clone, and checks that it is of the expected kind
+func (r *volumeCloneResource) getSource(plan models.VolumeCloneResourceModel) (*pftypes.Volume, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	sourceID, isSnapshot := plan.SourceVolumeID.ValueString(), false
+	if !plan.SourceSnapshotID.IsNull() {
+		sourceID, isSnapshot = plan.SourceSnapshotID.ValueString(), true
+	}
+
+	source, err := r.getVolume(sourceID)
+	if err != nil {
+		diags.AddError(
+			"Error getting source of volume clone",
+			"Could not get volume "+sourceID+", unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+	if isSnapshot && source.VolumeType != "Snapshot" {
+		diags.AddError(
+			"Invalid source snapshot",
+			"Volume "+sourceID+" is not a snapshot, clone it with source_volume_id.",
+		)
+	} else if !isSnapshot && source.VolumeType == "Snapshot" {
+		diags.AddError(
+			"Invalid source volume",
+			"Volume "+sourceID+" is a snapshot, clone it with source_snapshot_id.",
+		)
+	}
+	return source, diags
+}
+
+// getVolume returns a volume or a snapshot by its ID
+func (r *volumeCloneResource) getVolume(id string) (*pftypes.Volume, error) {
+	volumes, err := r.client.GetVolume("", id, "", "", false)
+	if err != nil {
+		return nil, err
+	}
+	if len(volumes) == 0 {
+		return nil, fmt.Errorf("could not find volume with ID %s", id)
+	}
+	return volumes[0], nil
+}
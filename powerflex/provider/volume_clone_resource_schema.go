@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// VolumeCloneResourceSchema variable to define schema for the volume clone resource
+var VolumeCloneResourceSchema schema.Schema = schema.Schema{
+	Description:         "This resource can be used to create writable clones of volumes and snapshots on a PowerFlex array.",
+	MarkdownDescription: "This resource can be used to create writable clones of volumes and snapshots on a PowerFlex array.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the volume clone.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the volume clone.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "The name of the volume clone.",
+			Required:            true,
+			MarkdownDescription: "The name of the volume clone.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"source_volume_id": schema.StringAttribute{
+			Description: "ID of the volume to clone." +
+				" Conflicts with 'source_snapshot_id'." +
+				" Cannot be updated.",
+			Optional: true,
+			MarkdownDescription: "ID of the volume to clone." +
+				" Conflicts with `source_snapshot_id`." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("source_snapshot_id")),
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"source_snapshot_id": schema.StringAttribute{
+			Description: "ID of the snapshot to clone." +
+				" Conflicts with 'source_volume_id'." +
+				" Cannot be updated.",
+			Optional: true,
+			MarkdownDescription: "ID of the snapshot to clone." +
+				" Conflicts with `source_volume_id`." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"size": schema.Int64Attribute{
+			Description: "Size of the volume clone. The unit of size is defined by 'capacity_unit'." +
+				" Defaults to the size of the source." +
+				" The storage capacity of a volume clone must be a multiple of 8GB and cannot be decreased, which is checked when planning.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "Size of the volume clone. The unit of size is defined by `capacity_unit`." +
+				" Defaults to the size of the source." +
+				" The storage capacity of a volume clone must be a multiple of 8GB and cannot be decreased, which is checked when planning.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"capacity_unit": schema.StringAttribute{
+			Description:         "Unit of capacity of the volume clone. Must be one of 'GB' and 'TB'. Default value is 'GB'.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Unit of capacity of the volume clone. Must be one of `GB` and `TB`. Default value is `GB`.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("GB", "TB"),
+				stringvalidator.AlsoRequires(path.MatchRoot("size")),
+			},
+			PlanModifiers: []planmodifier.String{
+				helper.StringDefault("GB"),
+			},
+		},
+		"size_in_kb": schema.Int64Attribute{
+			Description:         "Size of the volume clone in KB.",
+			Computed:            true,
+			MarkdownDescription: "Size of the volume clone in KB.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"access_mode": schema.StringAttribute{
+			Description:         "The access mode of the volume clone. Valid values are 'ReadOnly' and 'ReadWrite'. Default value is 'ReadWrite'.",
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "The access mode of the volume clone. Valid values are `ReadOnly` and `ReadWrite`. Default value is `ReadWrite`.",
+			Validators: []validator.String{
+				stringvalidator.OneOf(helper.READONLY, helper.READWRITE),
+			},
+			PlanModifiers: []planmodifier.String{
+				helper.StringDefault(helper.READWRITE),
+			},
+		},
+		"storage_pool_id": schema.StringAttribute{
+			Description:         "ID of the storage pool of the volume clone, which is the storage pool of its source.",
+			Computed:            true,
+			MarkdownDescription: "ID of the storage pool of the volume clone, which is the storage pool of its source.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"vtree_id": schema.StringAttribute{
+			Description:         "ID of the VTree of the volume clone, which it shares with its source.",
+			Computed:            true,
+			MarkdownDescription: "ID of the VTree of the volume clone, which it shares with its source.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var createVolumeCloneSources = `
+resource "powerflex_volume" "clone-source" {
+	name = "tfacc-clone-source"
+	protection_domain_name = "domain1"
+	storage_pool_name = "pool1"
+	size = 8
+}
+
+resource "powerflex_snapshot" "clone-source" {
+	name = "tfacc-clone-source-snap"
+	volume_id = powerflex_volume.clone-source.id
+}
+`
+
+var createVolumeClonePosTest = createVolumeCloneSources + `
+resource "powerflex_volume_clone" "from-snapshot" {
+	name = "tfacc-clone-from-snapshot"
+	source_snapshot_id = powerflex_snapshot.clone-source.id
+	size = 16
+}
+
+resource "powerflex_volume_clone" "from-volume" {
+	name = "tfacc-clone-from-volume"
+	source_volume_id = powerflex_volume.clone-source.id
+}
+`
+
+var updateVolumeClonePosTest = createVolumeCloneSources + `
+resource "powerflex_volume_clone" "from-snapshot" {
+	name = "tfacc-clone-from-snapshot-1"
+	source_snapshot_id = powerflex_snapshot.clone-source.id
+	size = 24
+	access_mode = "ReadOnly"
+}
+
+resource "powerflex_volume_clone" "from-volume" {
+	name = "tfacc-clone-from-volume"
+	source_volume_id = powerflex_volume.clone-source.id
+}
+`
+
+var updateVolumeCloneShrinkNegTest = createVolumeCloneSources + `
+resource "powerflex_volume_clone" "from-snapshot" {
+	name = "tfacc-clone-from-snapshot-1"
+	source_snapshot_id = powerflex_snapshot.clone-source.id
+	size = 16
+	access_mode = "ReadOnly"
+}
+
+resource "powerflex_volume_clone" "from-volume" {
+	name = "tfacc-clone-from-volume"
+	source_volume_id = powerflex_volume.clone-source.id
+}
+`
+
+var createVolumeCloneConflictTest = `
+resource "powerflex_volume_clone" "clone-invalid" {
+	name = "tfacc-clone-invalid"
+	source_volume_id = "volume-id"
+	source_snapshot_id = "snapshot-id"
+}
+`
+
+var createVolumeCloneOfSnapshotAsVolumeNegTest = createVolumeCloneSources + `
+resource "powerflex_volume_clone" "clone-invalid" {
+	name = "tfacc-clone-invalid"
+	source_volume_id = powerflex_snapshot.clone-source.id
+}
+`
+
+func TestAccVolumeCloneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createVolumeCloneConflictTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			{
+				Config:      ProviderConfigForTesting + createVolumeCloneOfSnapshotAsVolumeNegTest,
+				ExpectError: regexp.MustCompile(`.*Invalid source volume*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createVolumeClonePosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume_clone.from-snapshot", "name", "tfacc-clone-from-snapshot"),
+					resource.TestCheckResourceAttr("powerflex_volume_clone.from-snapshot", "size", "16"),
+					resource.TestCheckResourceAttr("powerflex_volume_clone.from-snapshot", "access_mode", "ReadWrite"),
+					resource.TestCheckResourceAttrPair("powerflex_volume_clone.from-snapshot", "vtree_id", "powerflex_volume_clone.from-volume", "vtree_id"),
+					resource.TestCheckResourceAttrPair("powerflex_volume_clone.from-snapshot", "storage_pool_id", "powerflex_volume.clone-source", "storage_pool_id"),
+					resource.TestCheckResourceAttr("powerflex_volume_clone.from-volume", "size", "8"),
+					resource.TestCheckResourceAttr("powerflex_volume_clone.from-volume", "capacity_unit", "GB"),
+					resource.TestCheckResourceAttr("powerflex_volume_clone.from-volume", "access_mode", "ReadWrite"),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_volume_clone.from-snapshot",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "powerflex_volume_clone.from-volume",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + updateVolumeClonePosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume_clone.from-snapshot", "name", "tfacc-clone-from-snapshot-1"),
+					resource.TestCheckResourceAttr("powerflex_volume_clone.from-snapshot", "size", "24"),
+					resource.TestCheckResourceAttr("powerflex_volume_clone.from-snapshot", "access_mode", "ReadOnly"),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + updateVolumeCloneShrinkNegTest,
+				ExpectError: regexp.MustCompile(`.*Size cannot be decreased*.`),
+			},
+		},
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"strconv"
 
+	"terraform-provider-powerflex/client"
 	"terraform-provider-powerflex/powerflex/helper"
 	"terraform-provider-powerflex/powerflex/models"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -46,7 +48,8 @@ func NewVolumeResource() resource.Resource {
 
 // volumeResource is the resource implementation.
 type volumeResource struct {
-	client *goscaleio.Client
+	client   *goscaleio.Client
+	systemID string
 }
 
 // Metadata returns the resource type name.
@@ -65,7 +68,9 @@ func (r *volumeResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	r.client = req.ProviderData.(*goscaleio.Client)
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
 }
 
 // ModifyPlan modify resource plan attribute value
@@ -78,18 +83,81 @@ func (r *volumeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	resp.Diagnostics.Append(diags...)
 
 	if !plan.Size.IsNull() && !plan.Size.IsUnknown() && !plan.CapacityUnit.IsUnknown() {
-		// check if size is in granularity of 8 or not
-		if plan.Size.ValueInt64()%8 != 0 && plan.CapacityUnit.ValueString() == "GB" {
-			resp.Diagnostics.AddError(
-				"Error: Size Must be in granularity of 8GB",
-				"Could not assign volume with size. sizeInGb ("+strconv.FormatInt(plan.Size.ValueInt64(), 10)+") must be a positive number in granularity of 8 GB.",
-			)
-		}
 		VSIKB := helper.ConvertToKB(plan.CapacityUnit.ValueString(), plan.Size.ValueInt64())
 		plan.SizeInKb = types.Int64Value(VSIKB)
 		diags = resp.Plan.Set(ctx, &plan)
 		resp.Diagnostics.Append(diags...)
 	}
+
+	// the state is null when the volume is created, its size is then 0
+	var state models.VolumeResourceModel
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// reject the sizes that cannot be applied before anything is changed on the volume
+	if !plan.SizeInKb.IsUnknown() {
+		diags = helper.ValidateVolumeSize(plan.SizeInKb.ValueInt64(), state.SizeInKb.ValueInt64())
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		// a change of the storage pool or of the protection domain migrates the volume, the ID or name
+		// not given in the configuration is only known once the new storage pool has been found
+		if !plan.StoragePoolName.IsUnknown() && !plan.StoragePoolName.Equal(state.StoragePoolName) {
+			plan.StoragePoolID = types.StringUnknown()
+		} else if !plan.StoragePoolID.IsUnknown() && !plan.StoragePoolID.Equal(state.StoragePoolID) {
+			plan.StoragePoolName = types.StringUnknown()
+		}
+		if !plan.ProtectionDomainName.IsUnknown() && !plan.ProtectionDomainName.Equal(state.ProtectionDomainName) {
+			plan.ProtectionDomainID = types.StringUnknown()
+		} else if !plan.ProtectionDomainID.IsUnknown() && !plan.ProtectionDomainID.Equal(state.ProtectionDomainID) {
+			plan.ProtectionDomainName = types.StringUnknown()
+		}
+		diags = resp.Plan.SetAttribute(ctx, path.Root("storage_pool_id"), plan.StoragePoolID)
+		resp.Diagnostics.Append(diags...)
+		diags = resp.Plan.SetAttribute(ctx, path.Root("storage_pool_name"), plan.StoragePoolName)
+		resp.Diagnostics.Append(diags...)
+		diags = resp.Plan.SetAttribute(ctx, path.Root("protection_domain_id"), plan.ProtectionDomainID)
+		resp.Diagnostics.Append(diags...)
+		diags = resp.Plan.SetAttribute(ctx, path.Root("protection_domain_name"), plan.ProtectionDomainName)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	diags = r.checkStoragePoolCapacity(plan, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// checkStoragePoolCapacity warns when the storage pool of the plan lacks the capacity the plan adds to the volume:
+// the whole size of the volume when it is created or migrated to another storage pool, the growth of its size otherwise.
+func (r *volumeResource) checkStoragePoolCapacity(plan, state models.VolumeResourceModel) (diags diag.Diagnostics) {
+	// the provider is not configured when the configuration is only validated
+	if r.client == nil || plan.SizeInKb.IsUnknown() {
+		return
+	}
+	if plan.ProtectionDomainID.IsUnknown() && plan.ProtectionDomainName.IsUnknown() ||
+		plan.StoragePoolID.IsUnknown() && plan.StoragePoolName.IsUnknown() {
+		return
+	}
+	// plan is a copy, resolving its storage pool does not change the plan of the resource.
+	// The capacity is not checked when the storage pool cannot be found: it may be created by the same apply,
+	// and the creation or the migration of the volume reports it otherwise.
+	pdr, diags := r.getProtectionDomainID(&plan)
+	if diags.HasError() {
+		return nil
+	}
+	if diags = r.getStoragePoolID(pdr, &plan); diags.HasError() {
+		return nil
+	}
+	growthInKb := plan.SizeInKb.ValueInt64()
+	if plan.StoragePoolID.Equal(state.StoragePoolID) {
+		growthInKb -= state.SizeInKb.ValueInt64()
+	}
+	return helper.CheckStoragePoolCapacity(r.client, plan.SystemID.ValueString(), plan.StoragePoolID.ValueString(), growthInKb)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -103,6 +171,15 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// bound the operation by the create timeout of the resource
+	createTimeout, diags := helper.GetCreateTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	pdr, diags = r.getProtectionDomainID(&plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -124,7 +201,7 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		VolumeSizeInKb:     strconv.FormatInt(plan.SizeInKb.ValueInt64(), 10),
 		Name:               plan.Name.ValueString(),
 	}
-	spr, err0 := helper.GetStoragePoolInstance(r.client, volumeCreate.StoragePoolID, volumeCreate.ProtectionDomainID)
+	spr, err0 := helper.GetStoragePoolInstance(r.client, plan.SystemID.ValueString(), volumeCreate.StoragePoolID, volumeCreate.ProtectionDomainID)
 	if err0 != nil {
 		resp.Diagnostics.AddError(
 			"Error getting storage pool with id: "+volumeCreate.StoragePoolID+" or protection pool with id: "+volumeCreate.ProtectionDomainID,
@@ -140,7 +217,11 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 		)
 		return
 	}
-	volCreateResponse, err1 := spr.CreateVolume(volumeCreate)
+	var volCreateResponse *pftypes.VolumeResp
+	err1 := helper.RunWithContext(ctx, func() (err error) {
+		volCreateResponse, err = spr.CreateVolume(volumeCreate)
+		return err
+	})
 	if err1 != nil {
 		resp.Diagnostics.AddError(
 			"Error creating volume",
@@ -160,7 +241,9 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 	vr := goscaleio.NewVolume(r.client)
 	vr.Volume = vol
 	if !plan.AccessMode.IsNull() {
-		err3 := vr.SetVolumeAccessModeLimit(plan.AccessMode.ValueString())
+		err3 := helper.RunWithContext(ctx, func() error {
+			return vr.SetVolumeAccessModeLimit(plan.AccessMode.ValueString())
+		})
 		if err3 != nil {
 			resp.Diagnostics.AddError(
 				"Error setting access mode on volume",
@@ -197,6 +280,12 @@ func (r *volumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 	volsResponse, err2 := r.client.GetVolume("", state.ID.ValueString(), "", "", false)
+	// remove the volume from the state when it has been deleted outside of terraform
+	if helper.IsNotFoundError(err2) || (err2 == nil && len(volsResponse) == 0) {
+		tflog.Warn(ctx, "[POWERFLEX] volume "+state.ID.ValueString()+" not found, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err2 != nil {
 		resp.Diagnostics.AddError(
 			"Error getting volume",
@@ -205,6 +294,18 @@ func (r *volumeResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 	vol := volsResponse[0]
+	// system_id is not known after an import, take it from the provider configuration
+	if state.SystemID.IsNull() {
+		system, err := helper.GetSystem(r.client, r.systemID, "")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in getting system instance on the PowerFlex cluster",
+				err.Error(),
+			)
+			return
+		}
+		state.SystemID = types.StringValue(system.System.ID)
+	}
 	dgs := helper.RefreshVolumeState(vol, &state)
 	resp.Diagnostics.Append(dgs...)
 	diags = resp.State.Set(ctx, state)
@@ -232,6 +333,27 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// bound the operation by the update timeout of the resource
+	updateTimeout, diags := helper.GetUpdateTimeout(plan.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// find the storage pool of the volume first, so that an invalid one is reported before anything is changed
+	pdr, diags := r.getProtectionDomainID(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = r.getStoragePoolID(pdr, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	volsplan, err2 := r.client.GetVolume("", state.ID.ValueString(), "", "", false)
 	if err2 != nil {
 		resp.Diagnostics.AddError(
@@ -240,17 +362,52 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		)
 		return
 	}
+	if len(volsplan) == 0 {
+		resp.Diagnostics.AddError(
+			"Error getting volume",
+			"volume with ID "+state.ID.ValueString()+" not found",
+		)
+		return
+	}
 	volresource := goscaleio.NewVolume(r.client)
 	volresource.Volume = volsplan[0]
 
+	// writeState refreshes the state from the volume and saves it. The update stops at the first step
+	// which fails, and the steps already done are kept in the state.
+	writeState := func() {
+		vols, err := r.client.GetVolume("", state.ID.ValueString(), "", "", false)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting volume",
+				"Could not get volume, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if len(vols) == 0 {
+			resp.Diagnostics.AddError(
+				"Error getting volume",
+				"volume with ID "+state.ID.ValueString()+" not found",
+			)
+			return
+		}
+		diags := helper.RefreshVolumeState(vols[0], &state)
+		resp.Diagnostics.Append(diags...)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+	}
+
 	// updating the name of volume if there is change in plan
 	if !plan.Name.IsUnknown() && plan.Name.ValueString() != state.Name.ValueString() {
-		err3 := volresource.SetVolumeName(plan.Name.ValueString())
+		err3 := helper.RunWithContext(ctx, func() error {
+			return volresource.SetVolumeName(plan.Name.ValueString())
+		})
 		if err3 != nil {
 			resp.Diagnostics.AddError(
 				"Error renaming the volume",
 				"unexpected error: "+err3.Error(),
 			)
+			writeState()
+			return
 		}
 	}
 
@@ -258,76 +415,82 @@ func (r *volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if plan.SizeInKb.ValueInt64() != state.SizeInKb.ValueInt64() {
 		sizeInGb := plan.SizeInKb.ValueInt64() / 1048576
 		sizeInGB := strconv.FormatInt(int64(sizeInGb), 10)
-		err4 := volresource.SetVolumeSize(sizeInGB)
+		err4 := helper.RunWithContext(ctx, func() error {
+			return volresource.SetVolumeSize(sizeInGB)
+		})
 		if err4 != nil {
 			resp.Diagnostics.AddError(
 				"Error setting the volume size",
 				"unexpected error: "+err4.Error(),
 			)
-		} else {
-			state.Size = plan.Size
-			state.CapacityUnit = plan.CapacityUnit
+			writeState()
+			return
 		}
+		state.Size = plan.Size
+		state.CapacityUnit = plan.CapacityUnit
 	}
 
-	// prompt error on change in volume type, as we can't update the volume type after the creation
-	if !plan.VolumeType.IsUnknown() && !plan.VolumeType.Equal(state.VolumeType) {
-		resp.Diagnostics.AddError(
-			"volume type cannot be update after volume creation.",
-			"unexpected error: volume type change is not supported",
-		)
+	// migrating the VTree of the volume if there is change in the storage pool or in the volume type
+	conversion := helper.VTreeConversion(state.VolumeType.ValueString(), plan.VolumeType.ValueString())
+	if plan.StoragePoolID.ValueString() != state.StoragePoolID.ValueString() || conversion != "" {
+		diags = r.migrateVolume(ctx, volresource.Volume, conversion, &plan, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			writeState()
+			return
+		}
 	}
+	state.StoragePoolID = plan.StoragePoolID
+	state.StoragePoolName = plan.StoragePoolName
+	state.ProtectionDomainID = plan.ProtectionDomainID
+	state.ProtectionDomainName = plan.ProtectionDomainName
 
 	// updating the use rm cache if there is change in plan
 	if !plan.UseRmCache.IsUnknown() && plan.UseRmCache.ValueBool() != state.UseRmCache.ValueBool() {
-		err5 := volresource.SetVolumeUseRmCache(plan.UseRmCache.ValueBool())
+		err5 := helper.RunWithContext(ctx, func() error {
+			return volresource.SetVolumeUseRmCache(plan.UseRmCache.ValueBool())
+		})
 		if err5 != nil {
 			resp.Diagnostics.AddError(
 				"Error setting the use rm cache",
 				"unexpected error: "+err5.Error(),
 			)
+			writeState()
+			return
 		}
 	}
 
 	// updating the compression if there is change in plan
 	if !plan.CompressionMethod.IsUnknown() && !plan.CompressionMethod.Equal(state.CompressionMethod) {
-		err6 := volresource.SetCompressionMethod(plan.CompressionMethod.ValueString())
+		err6 := helper.RunWithContext(ctx, func() error {
+			return volresource.SetCompressionMethod(plan.CompressionMethod.ValueString())
+		})
 		if err6 != nil {
 			resp.Diagnostics.AddError(
 				"Error setting the compression method",
 				"unexpected error: "+err6.Error(),
 			)
+			writeState()
+			return
 		}
 	}
 
 	// changing the access mode
 	if !plan.AccessMode.IsUnknown() && plan.AccessMode.ValueString() != state.AccessMode.ValueString() {
-		err := volresource.SetVolumeAccessModeLimit(plan.AccessMode.ValueString())
+		err := helper.RunWithContext(ctx, func() error {
+			return volresource.SetVolumeAccessModeLimit(plan.AccessMode.ValueString())
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error setting the access mode",
 				"unexpected error: "+err.Error(),
 			)
+			writeState()
+			return
 		}
 	}
 
-	vols, err2 := r.client.GetVolume("", state.ID.ValueString(), "", "", false)
-	if err2 != nil {
-		resp.Diagnostics.AddError(
-			"Error getting volume",
-			"Could not get volume, unexpected error: "+err2.Error(),
-		)
-		return
-	}
-	vol := vols[0]
-	dgs := helper.RefreshVolumeState(vol, &state)
-	resp.Diagnostics.Append(dgs...)
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	writeState()
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -336,6 +499,19 @@ func (r *volumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	var state models.VolumeResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// bound the operation by the delete timeout of the resource
+	deleteTimeout, diags := helper.GetDeleteTimeout(state.Timeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	volsplan, err1 := r.client.GetVolume("", state.ID.ValueString(), "", "", false)
 	if err1 != nil {
 		resp.Diagnostics.AddError(
@@ -344,11 +520,18 @@ func (r *volumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		)
 		return
 	}
+	// the volume has already been removed outside of terraform
+	if len(volsplan) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	volresource := goscaleio.NewVolume(r.client)
 	volresource.Volume = volsplan[0]
 
 	// finally removing the volume after unmap operation
-	err := volresource.RemoveVolume(state.RemoveMode.ValueString())
+	err := helper.RunWithContext(ctx, func() error {
+		return volresource.RemoveVolume(state.RemoveMode.ValueString())
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Removing Volume",
@@ -367,9 +550,64 @@ func (r *volumeResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// getProtectionDomainID updates the protection domain ID in the plan
+// migrateVolume migrates the VTree of the volume to the storage pool of the plan, converting its volume type,
+// and refreshes the state once the migration has completed. The migration runs with the VTree migration
+// I/O priority policy of the storage pools, set with the vtree_migration_* attributes of the storage pool resource.
+func (r *volumeResource) migrateVolume(ctx context.Context, vol *pftypes.Volume, conversion string, plan, state *models.VolumeResourceModel) (diags diag.Diagnostics) {
+	spr, err := helper.GetStoragePoolInstance(r.client, plan.SystemID.ValueString(), plan.StoragePoolID.ValueString(), plan.ProtectionDomainID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error getting storage pool with id: "+plan.StoragePoolID.ValueString(),
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	param := &client.VTreeMigrationParam{
+		DestSPID:          spr.StoragePool.ID,
+		VolTypeConversion: conversion,
+	}
+	// only the volumes of a fine granularity storage pool can be compressed
+	if spr.StoragePool.DataLayout == "FineGranularity" && !plan.CompressionMethod.IsUnknown() {
+		param.CompressionMethod = plan.CompressionMethod.ValueString()
+	}
+	tflog.Info(ctx, "[POWERFLEX] migrating VTree "+vol.VTreeID+" of volume "+vol.ID+" to storage pool "+spr.StoragePool.ID+
+		" with VTree migration I/O priority policy "+spr.StoragePool.VtreeMigrationIoPriorityPolicy)
+	if err := client.MigrateVTree(ctx, r.client, vol.ID, param); err != nil {
+		diags.AddError(
+			"Error migrating the volume",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err := helper.WaitForVTreeMigration(ctx, r.client, vol.VTreeID, spr.StoragePool.ID); err != nil {
+		diags.AddError(
+			"Error waiting for the migration of the volume",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	vols, err := r.client.GetVolume("", vol.ID, "", "", false)
+	if err != nil {
+		diags.AddError(
+			"Error getting volume after migration",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if len(vols) == 0 {
+		diags.AddError(
+			"Error getting volume after migration",
+			"volume with ID "+vol.ID+" not found",
+		)
+		return
+	}
+	return helper.RefreshVolumeState(vols[0], state)
+}
+
+// getProtectionDomainID updates the protection domain ID and the system ID in the plan
 func (r *volumeResource) getProtectionDomainID(plan *models.VolumeResourceModel) (*goscaleio.ProtectionDomain, diag.Diagnostics) {
-	sr, err := helper.GetFirstSystem(r.client)
+	sr, err := helper.GetSystem(r.client, helper.SystemIDOrDefault(plan.SystemID, r.systemID), "")
 	var diags diag.Diagnostics
 	if err != nil {
 		diags.AddError(
@@ -378,6 +616,7 @@ func (r *volumeResource) getProtectionDomainID(plan *models.VolumeResourceModel)
 		)
 		return nil, diags
 	}
+	plan.SystemID = types.StringValue(sr.System.ID)
 
 	pdr := goscaleio.NewProtectionDomain(r.client)
 
@@ -45,12 +45,12 @@ var VolumeResourceSchema schema.Schema = schema.Schema{
 		"storage_pool_id": schema.StringAttribute{
 			Description: "ID of the Storage Pool under which the volume will be created." +
 				" Conflicts with 'storage_pool_name'." +
-				" Cannot be updated.",
+				" Changing it migrates the volume, with its snapshots, to the new storage pool.",
 			Optional: true,
 			Computed: true,
 			MarkdownDescription: "ID of the Storage Pool under which the volume will be created." +
 				" Conflicts with `storage_pool_name`." +
-				" Cannot be updated.",
+				" Changing it migrates the volume, with its snapshots, to the new storage pool.",
 			Validators: []validator.String{
 				stringvalidator.LengthAtLeast(1),
 				stringvalidator.ExactlyOneOf(path.MatchRoot("storage_pool_name")),
@@ -62,12 +62,12 @@ var VolumeResourceSchema schema.Schema = schema.Schema{
 		"storage_pool_name": schema.StringAttribute{
 			Description: "Name of the Storage Pool under which the volume will be created." +
 				" Conflicts with 'storage_pool_id'." +
-				" Cannot be updated.",
+				" Changing it migrates the volume, with its snapshots, to the new storage pool.",
 			Optional: true,
 			Computed: true,
 			MarkdownDescription: "Name of the Storage Pool under which the volume will be created." +
 				" Conflicts with `storage_pool_id`." +
-				" Cannot be updated.",
+				" Changing it migrates the volume, with its snapshots, to the new storage pool.",
 			Validators: []validator.String{
 				stringvalidator.LengthAtLeast(1),
 				stringvalidator.ExactlyOneOf(path.MatchRoot("storage_pool_id")),
@@ -79,10 +79,10 @@ var VolumeResourceSchema schema.Schema = schema.Schema{
 		"protection_domain_id": schema.StringAttribute{
 			Description: "ID of the Protection Domain under which the volume will be created." +
 				" Conflicts with 'protection_domain_name'." +
-				" Cannot be updated.",
+				" Changing it migrates the volume, with its snapshots, to the storage pool of the new protection domain.",
 			MarkdownDescription: "ID of the Protection Domain under which the volume will be created." +
 				" Conflicts with `protection_domain_name`." +
-				" Cannot be updated.",
+				" Changing it migrates the volume, with its snapshots, to the storage pool of the new protection domain.",
 			Computed: true,
 			Optional: true,
 			Validators: []validator.String{
@@ -96,10 +96,10 @@ var VolumeResourceSchema schema.Schema = schema.Schema{
 		"protection_domain_name": schema.StringAttribute{
 			Description: "Name of the Protection Domain under which the volume will be created." +
 				" Conflicts with 'protection_domain_id'." +
-				" Cannot be updated.",
+				" Changing it migrates the volume, with its snapshots, to the storage pool of the new protection domain.",
 			MarkdownDescription: "Name of the Protection Domain under which the volume will be created." +
 				" Conflicts with `protection_domain_id`." +
-				" Cannot be updated.",
+				" Changing it migrates the volume, with its snapshots, to the storage pool of the new protection domain.",
 			Optional: true,
 			Computed: true,
 			Validators: []validator.String{
@@ -112,10 +112,12 @@ var VolumeResourceSchema schema.Schema = schema.Schema{
 		},
 		"size": schema.Int64Attribute{
 			Description: "Size of the volume. The unit of size is defined by 'capacity_unit'." +
-				" The storage capacity of a volume must be a multiple of 8GB and cannot be decreased.",
+				" The storage capacity of a volume must be a multiple of 8GB and cannot be decreased, which is checked when planning." +
+				" A warning is raised when the storage pool lacks the capacity for the new size.",
 			Required: true,
 			MarkdownDescription: "Size of the volume. The unit of size is defined by `capacity_unit`." +
-				" The storage capacity of a volume must be a multiple of 8GB and cannot be decreased.",
+				" The storage capacity of a volume must be a multiple of 8GB and cannot be decreased, which is checked when planning." +
+				" A warning is raised when the storage pool lacks the capacity for the new size.",
 		},
 		"capacity_unit": schema.StringAttribute{
 			Description:         "Unit of capacity of the volume. Must be one of 'GB' and 'TB'. Default value is 'GB'.",
@@ -131,10 +133,12 @@ var VolumeResourceSchema schema.Schema = schema.Schema{
 			},
 		},
 		"volume_type": schema.StringAttribute{
-			Description:         "Volume type. Valid values are 'ThickProvisioned' and 'ThinProvisioned'. Default value is 'ThinProvisioned'.",
-			Optional:            true,
-			Computed:            true,
-			MarkdownDescription: "Volume type. Valid values are `ThickProvisioned` and `ThinProvisioned`. Default value is `ThinProvisioned`.",
+			Description: "Volume type. Valid values are 'ThickProvisioned' and 'ThinProvisioned'. Default value is 'ThinProvisioned'." +
+				" Changing it converts the volume through a migration of its VTree.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "Volume type. Valid values are `ThickProvisioned` and `ThinProvisioned`. Default value is `ThinProvisioned`." +
+				" Changing it converts the volume through a migration of its VTree.",
 			Validators: []validator.String{stringvalidator.OneOf(
 				"ThickProvisioned",
 				"ThinProvisioned",
@@ -204,5 +208,25 @@ var VolumeResourceSchema schema.Schema = schema.Schema{
 				helper.StringDefault("ONLY_ME"),
 			},
 		},
+		"system_id": schema.StringAttribute{
+			Description: "ID of the PowerFlex system on which the volume will be created." +
+				" Defaults to the system configured on the provider." +
+				" Cannot be updated.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "ID of the PowerFlex system on which the volume will be created." +
+				" Defaults to the system configured on the provider." +
+				" Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"timeouts": TimeoutsBlock,
 	},
 }
@@ -18,10 +18,16 @@ limitations under the License.
 package provider
 
 import (
+	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/dell/goscaleio"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccVolumeResource(t *testing.T) {
@@ -129,6 +135,31 @@ func TestAccVolumeResource(t *testing.T) {
 	}
 	`
 
+	var updateVolumeSizePosTest = `
+	resource "powerflex_volume" "avengers-volume-create-01"{
+		name = "avengers-volume-create-01"
+		protection_domain_name = "domain1"
+		storage_pool_name = "pool1" #pool1 have medium granularity
+		size = 16
+		use_rm_cache = true 
+		volume_type = "ThickProvisioned"
+		access_mode = "ReadWrite"
+	}
+	`
+
+	// the size of a volume cannot be decreased, the plan fails
+	var updateVolumeShrinkNegTest = `
+	resource "powerflex_volume" "avengers-volume-create-01"{
+		name = "avengers-volume-create-01"
+		protection_domain_name = "domain1"
+		storage_pool_name = "pool1" #pool1 have medium granularity
+		size = 8
+		use_rm_cache = true 
+		volume_type = "ThickProvisioned"
+		access_mode = "ReadOnly"
+	}
+	`
+
 	var createVolumeCompressionMethodNegTest = `
 	resource "powerflex_volume" "avengers-volume-create-compression"{
 		name = "volume-create-compression"
@@ -149,7 +180,7 @@ func TestAccVolumeResource(t *testing.T) {
 	}
 	`
 
-	var updateVolumeTypeNegTest = `
+	var updateVolumeTypePosTest = `
 	resource "powerflex_volume" "avengers-volume-create-volume-type"{
 		name = "volume-create-volume-type"
 		protection_domain_name = "domain1"
@@ -158,6 +189,26 @@ func TestAccVolumeResource(t *testing.T) {
 		volume_type = "ThickProvisioned"
 	}
 	`
+	var createVolumeWithInvalidSystemNegTest = `
+	resource "powerflex_volume" "volume-system-invalid"{
+		name = "volume-with-invalid-system"
+		protection_domain_name = "domain1"
+		storage_pool_name = "pool1"
+		size = 8
+		system_id = "invalid-system-id"
+	}
+	`
+	var createVolumeWithInvalidTimeoutNegTest = `
+	resource "powerflex_volume" "volume-timeout-invalid"{
+		name = "volume-with-invalid-timeout"
+		protection_domain_name = "domain1"
+		storage_pool_name = "pool1"
+		size = 8
+		timeouts {
+			create = "ten minutes"
+		}
+	}
+	`
 	var createVolumeWithInvalidSizeNegTest = `
 	resource "powerflex_volume" "volume-size-invalid"{
 		name = "volume-with-invalid-size"
@@ -196,6 +247,7 @@ func TestAccVolumeResource(t *testing.T) {
 				Config: ProviderConfigForTesting + createVolumePosTest,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("powerflex_volume.avengers-volume-create", "name", "avengers-volume-create"),
+					resource.TestCheckResourceAttrSet("powerflex_volume.avengers-volume-create", "system_id"),
 				),
 			},
 			{
@@ -220,6 +272,23 @@ func TestAccVolumeResource(t *testing.T) {
 				Config:      ProviderConfigForTesting + updateVolumeSizeNegTest,
 				ExpectError: regexp.MustCompile(`.*Error setting the volume size*.`),
 			},
+			{
+				Config: ProviderConfigForTesting + updateVolumeSizePosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume.avengers-volume-create-01", "size", "16"),
+				),
+			},
+			// the access mode is not changed since the plan is rejected before the volume is updated
+			{
+				Config:      ProviderConfigForTesting + updateVolumeShrinkNegTest,
+				ExpectError: regexp.MustCompile(`.*Size cannot be decreased*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + updateVolumeSizePosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume.avengers-volume-create-01", "access_mode", "ReadWrite"),
+				),
+			},
 			{
 				Config:      ProviderConfigForTesting + createVolumeCompressionMethodNegTest,
 				ExpectError: regexp.MustCompile(`.*error setting the compression method*.`),
@@ -230,9 +299,21 @@ func TestAccVolumeResource(t *testing.T) {
 					resource.TestCheckResourceAttr("powerflex_volume.avengers-volume-create-volume-type", "volume_type", "ThinProvisioned"),
 				),
 			},
+			// the volume type is converted through a VTree migration
+			{
+				Config: ProviderConfigForTesting + updateVolumeTypePosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume.avengers-volume-create-volume-type", "volume_type", "ThickProvisioned"),
+					resource.TestCheckResourceAttr("powerflex_volume.avengers-volume-create-volume-type", "storage_pool_name", "pool1"),
+				),
+			},
 			{
-				Config:      ProviderConfigForTesting + updateVolumeTypeNegTest,
-				ExpectError: regexp.MustCompile(`.*volume type cannot be update after volume creation*.`),
+				Config:      ProviderConfigForTesting + createVolumeWithInvalidSystemNegTest,
+				ExpectError: regexp.MustCompile(`.*system with ID invalid-system-id not found*.`),
+			},
+			{
+				Config:      ProviderConfigForTesting + createVolumeWithInvalidTimeoutNegTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Duration*.`),
 			},
 			{
 				Config:      ProviderConfigForTesting + createVolumeWithInvalidSizeNegTest,
@@ -242,6 +323,90 @@ func TestAccVolumeResource(t *testing.T) {
 	})
 }
 
+func TestAccVolumeResourceMigration(t *testing.T) {
+	var createVolumeMigrationTest = `
+	resource "powerflex_volume" "volume-migration"{
+		name = "volume-migration"
+		protection_domain_name = "domain1"
+		storage_pool_name = "pool1"
+		size = 8
+		volume_type = "ThickProvisioned"
+	}
+	`
+
+	var migrateThickVolumeNegTest = `
+	resource "powerflex_volume" "volume-migration"{
+		name = "volume-migration"
+		protection_domain_name = "domain1"
+		storage_pool_name = "pool2" #pool2 have fine granularity
+		size = 8
+		volume_type = "ThickProvisioned"
+	}
+	`
+
+	var migrateVolumePosTest = `
+	resource "powerflex_volume" "volume-migration"{
+		name = "volume-migration"
+		protection_domain_name = "domain1"
+		storage_pool_name = "pool2" #pool2 have fine granularity
+		size = 8
+		volume_type = "ThinProvisioned"
+		compression_method = "Normal"
+	}
+	`
+
+	var migrateVolumeInvalidPoolNegTest = `
+	resource "powerflex_volume" "volume-migration"{
+		name = "volume-migration-renamed"
+		protection_domain_name = "domain1"
+		storage_pool_name = "invalid-pool-name"
+		size = 8
+		volume_type = "ThinProvisioned"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfigForTesting + createVolumeMigrationTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume.volume-migration", "storage_pool_name", "pool1"),
+					resource.TestCheckResourceAttr("powerflex_volume.volume-migration", "volume_type", "ThickProvisioned"),
+				),
+			},
+			// fine granularity storage pools only hold thin provisioned volumes
+			{
+				Config:      ProviderConfigForTesting + migrateThickVolumeNegTest,
+				ExpectError: regexp.MustCompile(`.*Error migrating the volume*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + migrateVolumePosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume.volume-migration", "storage_pool_name", "pool2"),
+					resource.TestCheckResourceAttrSet("powerflex_volume.volume-migration", "storage_pool_id"),
+					resource.TestCheckResourceAttr("powerflex_volume.volume-migration", "volume_type", "ThinProvisioned"),
+					resource.TestCheckResourceAttr("powerflex_volume.volume-migration", "compression_method", "Normal"),
+					resource.TestCheckResourceAttr("powerflex_volume.volume-migration", "size_in_kb", "8388608"),
+				),
+			},
+			// an invalid storage pool is reported before the volume is renamed
+			{
+				Config:      ProviderConfigForTesting + migrateVolumeInvalidPoolNegTest,
+				ExpectError: regexp.MustCompile(`.*Error getting storage pool*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + migrateVolumePosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume.volume-migration", "name", "volume-migration"),
+					resource.TestCheckResourceAttr("powerflex_volume.volume-migration", "storage_pool_name", "pool2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVolumeResourceImport(t *testing.T) {
 	resourceName := "powerflex_volume.tf_create"
 	resource.Test(t, resource.TestCase{
@@ -297,3 +462,68 @@ func TestAccVolumeResourceImport(t *testing.T) {
 		},
 	})
 }
+
+func TestAccVolumeResourceDeletedOutsideTerraform(t *testing.T) {
+	resourceName := "powerflex_volume.tf_drift"
+	volumeConfig := ProviderConfigForTesting + `
+	resource "powerflex_volume" "tf_drift"{
+		name = "volume-drift-tf"
+		protection_domain_name = "domain1"
+		storage_pool_name = "pool1"
+		size = 8
+	}
+	`
+	var volumeID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: volumeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "volume-drift-tf"),
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources[resourceName]
+						if !ok {
+							return fmt.Errorf("resource %s not found in state", resourceName)
+						}
+						volumeID = rs.Primary.ID
+						return nil
+					},
+				),
+			},
+			// delete the volume behind terraform's back, the next plan must propose to create it again
+			{
+				PreConfig: func() {
+					if err := deleteVolumeForTest(volumeID); err != nil {
+						t.Fatalf("could not delete volume %s: %s", volumeID, err.Error())
+					}
+				},
+				Config:             volumeConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// deleteVolumeForTest removes a volume directly through the PowerFlex API
+func deleteVolumeForTest(volumeID string) error {
+	client, err := goscaleio.NewClientWithArgs(os.Getenv("POWERFLEX_ENDPOINT"), "", 120, true, true)
+	if err != nil {
+		return err
+	}
+	_, err = client.Authenticate(&goscaleio.ConfigConnect{
+		Endpoint: os.Getenv("POWERFLEX_ENDPOINT"),
+		Username: os.Getenv("POWERFLEX_USERNAME"),
+		Password: os.Getenv("POWERFLEX_PASSWORD"),
+	})
+	if err != nil {
+		return err
+	}
+	volume, err := helper.GetVolumeType(client, volumeID)
+	if err != nil {
+		return err
+	}
+	return volume.RemoveVolume("ONLY_ME")
+}
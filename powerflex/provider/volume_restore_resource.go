@@ -0,0 +1,188 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/client"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &volumeRestoreResource{}
+	_ resource.ResourceWithConfigure = &volumeRestoreResource{}
+)
+
+// NewVolumeRestoreResource is a helper function to simplify the provider implementation.
+func NewVolumeRestoreResource() resource.Resource {
+	return &volumeRestoreResource{}
+}
+
+// volumeRestoreResource is the resource implementation.
+type volumeRestoreResource struct {
+	client *goscaleio.Client
+}
+
+// Metadata returns the resource type name.
+func (r *volumeRestoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_restore"
+}
+
+// Schema defines the schema for the resource.
+func (r *volumeRestoreResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = VolumeRestoreResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *volumeRestoreResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+}
+
+// Create restores the target volume from the snapshot and sets the initial Terraform state.
+func (r *volumeRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.VolumeRestoreResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.restore(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data of the target volume.
+func (r *volumeRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.VolumeRestoreResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, err := helper.GetVolumeType(r.client, state.TargetVolumeID.ValueString())
+	if err != nil {
+		// remove the restore from the state when the target volume has been deleted
+		if helper.IsNotFoundError(err) {
+			tflog.Warn(ctx, "[POWERFLEX] volume "+state.TargetVolumeID.ValueString()+" not found, removing its restore from the state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error getting volume",
+			"Could not get volume, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	helper.UpdateVolumeRestoreState(target.Volume, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update restores the target volume again and sets the updated Terraform state on success.
+// The target volume requires a replacement, so the update is caused by a new source snapshot or a new trigger.
+func (r *volumeRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.VolumeRestoreResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.restore(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the restore from the Terraform state, the volume is left as is.
+func (r *volumeRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.VolumeRestoreResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "[POWERFLEX] removing restore of volume "+state.TargetVolumeID.ValueString()+" from the state")
+	resp.State.RemoveResource(ctx)
+}
+
+// restore overwrites the content of the target volume of the plan with the content of the source snapshot,
+// once it has checked that both belong to the same VTree.
+func (r *volumeRestoreResource) restore(ctx context.Context, plan *models.VolumeRestoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	targetID, snapshotID := plan.TargetVolumeID.ValueString(), plan.SourceSnapshotID.ValueString()
+
+	target, err := helper.GetVolumeType(r.client, targetID)
+	if err != nil {
+		diags.AddError(
+			"Error getting volume to restore",
+			"Could not get volume "+targetID+", unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	snapshot, err := helper.GetVolumeType(r.client, snapshotID)
+	if err != nil {
+		diags.AddError(
+			"Error getting snapshot to restore from",
+			"Could not get snapshot "+snapshotID+", unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	if err := helper.ValidateVolumeRestore(target.Volume, snapshot.Volume); err != nil {
+		diags.AddError(
+			"Invalid snapshot to restore volume "+targetID+" from",
+			err.Error(),
+		)
+		return diags
+	}
+
+	err = client.OverwriteVolumeContent(ctx, r.client, targetID, &client.OverwriteVolumeContentParam{SrcVolumeID: snapshotID})
+	if err != nil {
+		diags.AddError(
+			"Error restoring volume "+targetID+" from snapshot "+snapshotID,
+			"unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+	tflog.Info(ctx, "[POWERFLEX] volume "+targetID+" restored from snapshot "+snapshotID)
+
+	helper.UpdateVolumeRestoreState(target.Volume, plan)
+	return diags
+}
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// VolumeRestoreResourceSchema variable to define schema for the volume restore resource
+var VolumeRestoreResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource can be used to restore the content of a volume from a snapshot of its VTree on a PowerFlex array." +
+		" The content of the target volume is overwritten when the resource is created, and again whenever the source snapshot or the trigger is updated." +
+		" Destroying the resource does not change the volume.",
+	MarkdownDescription: "This resource can be used to restore the content of a volume from a snapshot of its VTree on a PowerFlex array." +
+		" The content of the target volume is overwritten when the resource is created, and again whenever the `source_snapshot_id` or the `trigger` is updated." +
+		" Destroying the resource does not change the volume.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the restored volume.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the restored volume.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"target_volume_id": schema.StringAttribute{
+			Description:         "ID of the volume whose content is overwritten. Cannot be updated.",
+			Required:            true,
+			MarkdownDescription: "ID of the volume whose content is overwritten. Cannot be updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"source_snapshot_id": schema.StringAttribute{
+			Description: "ID of the snapshot whose content overwrites the target volume." +
+				" It must belong to the VTree of the target volume." +
+				" The target volume is restored again whenever it is updated.",
+			Required: true,
+			MarkdownDescription: "ID of the snapshot whose content overwrites the target volume." +
+				" It must belong to the VTree of the target volume." +
+				" The target volume is restored again whenever it is updated.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"trigger": schema.StringAttribute{
+			Description: "Any value, like a timestamp or a version number." +
+				" The target volume is restored again whenever it is updated.",
+			Optional: true,
+			MarkdownDescription: "Any value, like a timestamp or a version number." +
+				" The target volume is restored again whenever it is updated.",
+		},
+		"vtree_id": schema.StringAttribute{
+			Description:         "ID of the VTree of the target volume and of the source snapshot.",
+			Computed:            true,
+			MarkdownDescription: "ID of the VTree of the target volume and of the source snapshot.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var createVolumeRestoreSources = `
+resource "powerflex_volume" "restore-target" {
+	name = "tfacc-restore-target"
+	protection_domain_name = "domain1"
+	storage_pool_name = "pool1"
+	size = 8
+}
+
+resource "powerflex_snapshot" "restore-golden" {
+	name = "tfacc-restore-golden"
+	volume_id = powerflex_volume.restore-target.id
+}
+
+resource "powerflex_volume" "restore-other" {
+	name = "tfacc-restore-other"
+	protection_domain_name = "domain1"
+	storage_pool_name = "pool1"
+	size = 8
+}
+
+resource "powerflex_snapshot" "restore-other" {
+	name = "tfacc-restore-other-snap"
+	volume_id = powerflex_volume.restore-other.id
+}
+`
+
+var createVolumeRestoreOtherVTreeNegTest = createVolumeRestoreSources + `
+resource "powerflex_volume_restore" "restore" {
+	target_volume_id = powerflex_volume.restore-target.id
+	source_snapshot_id = powerflex_snapshot.restore-other.id
+}
+`
+
+var createVolumeRestorePosTest = createVolumeRestoreSources + `
+resource "powerflex_volume_restore" "restore" {
+	target_volume_id = powerflex_volume.restore-target.id
+	source_snapshot_id = powerflex_snapshot.restore-golden.id
+	trigger = "1"
+}
+`
+
+var updateVolumeRestoreTriggerPosTest = createVolumeRestoreSources + `
+resource "powerflex_volume_restore" "restore" {
+	target_volume_id = powerflex_volume.restore-target.id
+	source_snapshot_id = powerflex_snapshot.restore-golden.id
+	trigger = "2"
+}
+`
+
+var updateVolumeRestoreItselfNegTest = createVolumeRestoreSources + `
+resource "powerflex_volume_restore" "restore" {
+	target_volume_id = powerflex_volume.restore-target.id
+	source_snapshot_id = powerflex_volume.restore-target.id
+	trigger = "2"
+}
+`
+
+func TestAccVolumeRestoreResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + createVolumeRestoreOtherVTreeNegTest,
+				ExpectError: regexp.MustCompile(`.*only be restored from a snapshot of its own VTree*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createVolumeRestorePosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("powerflex_volume_restore.restore", "id", "powerflex_volume.restore-target", "id"),
+					resource.TestCheckResourceAttr("powerflex_volume_restore.restore", "trigger", "1"),
+					resource.TestCheckResourceAttrSet("powerflex_volume_restore.restore", "vtree_id"),
+				),
+			},
+			// a new trigger restores the volume again
+			{
+				Config: ProviderConfigForTesting + updateVolumeRestoreTriggerPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume_restore.restore", "trigger", "2"),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + updateVolumeRestoreItselfNegTest,
+				ExpectError: regexp.MustCompile(`.*cannot be restored from itself*.`),
+			},
+		},
+	})
+}
@@ -0,0 +1,440 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	pftypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &volumeSdcMappingResource{}
+	_ resource.ResourceWithConfigure   = &volumeSdcMappingResource{}
+	_ resource.ResourceWithImportState = &volumeSdcMappingResource{}
+	_ resource.ResourceWithModifyPlan  = &volumeSdcMappingResource{}
+)
+
+// NewVolumeSdcMappingResource is a helper function to simplify the provider implementation.
+func NewVolumeSdcMappingResource() resource.Resource {
+	return &volumeSdcMappingResource{}
+}
+
+// volumeSdcMappingResource is the resource implementation.
+// It maps one volume to many SDCs, such as a datastore shared by the SDCs of an ESXi cluster,
+// and diffs the SDCs of the plan against the mappings of the volume.
+type volumeSdcMappingResource struct {
+	client   *goscaleio.Client
+	systemID string
+}
+
+// Metadata returns the resource type name.
+func (r *volumeSdcMappingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volume_sdc_mapping"
+}
+
+// Schema defines the schema for the resource.
+func (r *volumeSdcMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = VolumeSdcMappingResourceSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *volumeSdcMappingResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	p := req.ProviderData.(*powerflexProvider)
+	r.client = p.client
+	r.systemID = p.systemID
+}
+
+// ModifyPlan resolves the volume and the SDCs of the plan, so that the plan matches the state once they are mapped.
+// The resource is replaced when it is moved to another volume.
+func (r *volumeSdcMappingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The provider is not configured when the configuration is only validated.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var plan models.VolumeSdcMappingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	// the state is null when the mapping is created
+	var state models.VolumeSdcMappingResourceModel
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.resolvePlan(ctx, &plan, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !state.ID.IsNull() && !plan.VolumeID.Equal(state.VolumeID) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("volume_id"))
+	}
+
+	diags = resp.Plan.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *volumeSdcMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.VolumeSdcMappingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.resolvePlan(ctx, &plan, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.mapVolume(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	// the state is saved even when some SDCs could not be mapped, so that the mappings done are not lost
+	diags = r.readMappings(plan.VolumeID.ValueString(), &plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *volumeSdcMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.VolumeSdcMappingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// remove the mapping from the state when the volume has been removed outside of terraform
+	if _, err := helper.GetVolumeType(r.client, state.ID.ValueString()); helper.IsNotFoundError(err) {
+		tflog.Warn(ctx, "[POWERFLEX] volume "+state.ID.ValueString()+" not found, removing its SDC mappings from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = r.readMappings(state.ID.ValueString(), &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *volumeSdcMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.VolumeSdcMappingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.resolvePlan(ctx, &plan, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.mapVolume(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	diags = r.readMappings(plan.VolumeID.ValueString(), &plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete unmaps the SDCs of the state from the volume and removes the Terraform state on success.
+func (r *volumeSdcMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.VolumeSdcMappingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volume, err := helper.GetVolumeType(r.client, state.ID.ValueString())
+	if helper.IsNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting volume",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sdcList := []models.VolumeSdcModel{}
+	diags = state.SdcList.ElementsAs(ctx, &sdcList, true)
+	resp.Diagnostics.Append(diags...)
+	for _, sdc := range sdcList {
+		// the SDCs already unmapped outside of terraform are skipped
+		if !isMappedToSdc(volume.Volume, sdc.SdcID.ValueString()) {
+			continue
+		}
+		err := volume.UnmapVolumeSdc(&pftypes.UnmapVolumeSdcParam{
+			SdcID: sdc.SdcID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Unmapping Volume to SDCs",
+				"Couldn't unmap volume from SDC with id: "+sdc.SdcID.ValueString()+", unexpected error: "+err.Error(),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the mappings of a volume by the ID of the volume.
+func (r *volumeSdcMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// resolvePlan fills in the ID and the name of the volume, and the identifiers, limits and access mode of the SDCs.
+// The volume and the SDCs which are not known yet are left as they are.
+// When planning, the volume is also left unknown when it is not found, as it may be created in the same apply.
+func (r *volumeSdcMappingResource) resolvePlan(ctx context.Context, plan *models.VolumeSdcMappingResourceModel, planning bool) (diags diag.Diagnostics) {
+	var volumes []*pftypes.Volume
+	var err error
+	var filter string
+	if !plan.VolumeID.IsUnknown() {
+		filter = "ID: " + plan.VolumeID.ValueString()
+		volumes, err = r.client.GetVolume("", plan.VolumeID.ValueString(), "", "", false)
+	} else if !plan.VolumeName.IsUnknown() {
+		filter = "name: " + plan.VolumeName.ValueString()
+		// the volume is not found when the name is unknown to the array
+		volumes, err = r.client.GetVolume("", "", "", plan.VolumeName.ValueString(), false)
+	}
+	if filter != "" {
+		notFound := err == nil && len(volumes) == 0 || helper.IsNotFoundError(err)
+		if notFound && planning {
+			tflog.Info(ctx, "[POWERFLEX] volume with "+filter+" not found, it is looked up again when applying")
+		} else if notFound {
+			diags.AddError(
+				"Error getting volume with "+filter,
+				"Could not find volume with "+filter,
+			)
+			return
+		} else if err != nil {
+			diags.AddError(
+				"Error getting volume with "+filter,
+				"Could not get volume with "+filter+", unexpected error: "+err.Error(),
+			)
+			return
+		} else {
+			plan.VolumeID = types.StringValue(volumes[0].ID)
+			plan.VolumeName = types.StringValue(volumes[0].Name)
+		}
+	}
+	plan.ID = plan.VolumeID
+	if plan.SdcList.IsUnknown() {
+		return
+	}
+
+	system, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		diags.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+	sdcs, err := system.GetSdc()
+	if err != nil {
+		diags.AddError(
+			"Error getting SDCs",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	sdcList := []models.VolumeSdcModel{}
+	diags.Append(plan.SdcList.ElementsAs(ctx, &sdcList, true)...)
+	diags.Append(helper.ResolveVolumeSdcs(sdcs, sdcList)...)
+	if diags.HasError() {
+		return
+	}
+	sdcSet, dgs := helper.GetVolumeSdcSetValue(sdcList)
+	diags.Append(dgs...)
+	plan.SdcList = sdcSet
+	return
+}
+
+// mapVolume maps the volume to the SDCs of the plan, unmaps it from the other SDCs,
+// and sets the limits and the access mode of the mappings which differ from the plan.
+func (r *volumeSdcMappingResource) mapVolume(ctx context.Context, plan models.VolumeSdcMappingResourceModel) (diags diag.Diagnostics) {
+	volume, err := helper.GetVolumeType(r.client, plan.VolumeID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error getting volume",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	planSdcList := []models.VolumeSdcModel{}
+	diags.Append(plan.SdcList.ElementsAs(ctx, &planSdcList, true)...)
+	if diags.HasError() {
+		return
+	}
+	planSdcIds := make(map[string]string)
+	for _, sdc := range planSdcList {
+		planSdcIds[sdc.SdcID.ValueString()] = sdc.SdcID.ValueString()
+	}
+	mappedSdcs := make(map[string]*pftypes.MappedSdcInfo)
+	mappedSdcIds := make(map[string]string)
+	for _, info := range volume.Volume.MappedSdcInfo {
+		mappedSdcs[info.SdcID] = info
+		mappedSdcIds[info.SdcID] = info.SdcID
+	}
+
+	// unmap the SDCs which are not in the plan
+	for sdcID := range helper.DifferenceMap(mappedSdcIds, planSdcIds) {
+		tflog.Info(ctx, "[POWERFLEX] unmapping volume "+volume.Volume.ID+" from SDC "+sdcID)
+		err := volume.UnmapVolumeSdc(&pftypes.UnmapVolumeSdcParam{
+			SdcID: sdcID,
+		})
+		if err != nil {
+			diags.AddError(
+				"Error unmapping sdc: "+sdcID,
+				"unexpected error: "+err.Error(),
+			)
+		}
+	}
+
+	for _, sdc := range planSdcList {
+		sdcID := sdc.SdcID.ValueString()
+		mapping, ok := mappedSdcs[sdcID]
+		if !ok {
+			tflog.Info(ctx, "[POWERFLEX] mapping volume "+volume.Volume.ID+" to SDC "+sdcID)
+			err := volume.MapVolumeSdc(&pftypes.MapVolumeSdcParam{
+				SdcID:                 sdcID,
+				AccessMode:            sdc.AccessMode.ValueString(),
+				AllowMultipleMappings: "true",
+			})
+			if err != nil {
+				diags.AddError(
+					"Error mapping sdc: "+sdcID,
+					"unexpected error: "+err.Error(),
+				)
+				continue
+			}
+			// a new mapping has no limits
+			mapping = &pftypes.MappedSdcInfo{SdcID: sdcID, AccessMode: sdc.AccessMode.ValueString()}
+		} else if mapping.AccessMode != sdc.AccessMode.ValueString() {
+			err := volume.SetVolumeMappingAccessMode(sdc.AccessMode.ValueString(), sdcID)
+			if err != nil {
+				diags.AddError(
+					"Error setting access mode to sdc: "+sdcID,
+					"unexpected error: "+err.Error(),
+				)
+			}
+		}
+
+		if int64(mapping.LimitIops) != sdc.IOPSLimit.ValueInt64() || int64(mapping.LimitBwInMbps) != sdc.BWLimit.ValueInt64() {
+			err := volume.SetMappedSdcLimits(&pftypes.SetMappedSdcLimitsParam{
+				SdcID:                sdcID,
+				BandwidthLimitInKbps: strconv.FormatInt(sdc.BWLimit.ValueInt64()*1024, 10),
+				IopsLimit:            strconv.FormatInt(sdc.IOPSLimit.ValueInt64(), 10),
+			})
+			if err != nil {
+				diags.AddError(
+					"Error setting limits to sdc: "+sdcID,
+					"unexpected error: "+err.Error(),
+				)
+			}
+		}
+	}
+	return
+}
+
+// readMappings saves the volume and the SDCs mapped to it in the state
+func (r *volumeSdcMappingResource) readMappings(volumeID string, state *models.VolumeSdcMappingResourceModel) (diags diag.Diagnostics) {
+	volume, err := helper.GetVolumeType(r.client, volumeID)
+	if err != nil {
+		diags.AddError(
+			"Error getting volume",
+			"Could not get volume "+volumeID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	system, err := helper.GetSystem(r.client, r.systemID, "")
+	if err != nil {
+		diags.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+	sdcs, err := system.GetSdc()
+	if err != nil {
+		diags.AddError(
+			"Error getting SDCs",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	return helper.UpdateVolumeSdcMappingState(volume.Volume, sdcs, state)
+}
+
+// isMappedToSdc tells whether a volume is mapped to an SDC
+func isMappedToSdc(volume *pftypes.Volume, sdcID string) bool {
+	for _, info := range volume.MappedSdcInfo {
+		if info.SdcID == sdcID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// VolumeSdcMappingResourceSchema variable to define schema for the volume SDC mapping resource
+var VolumeSdcMappingResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource can be used to manage mapping of a volume to SDCs on a PowerFlex array." +
+		" The resource manages all the mappings of the volume: SDCs mapped to the volume outside of the resource are unmapped.",
+	MarkdownDescription: "This resource can be used to manage mapping of a volume to SDCs on a PowerFlex array." +
+		" The resource manages all the mappings of the volume: SDCs mapped to the volume outside of the resource are unmapped.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "The ID of the volume.",
+			Computed:            true,
+			MarkdownDescription: "The ID of the volume.",
+		},
+		"volume_id": schema.StringAttribute{
+			Description: "The ID of the volume." +
+				" Conflicts with 'volume_name'." +
+				" Changing the volume replaces the resource.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The ID of the volume." +
+				" Conflicts with `volume_name`." +
+				" Changing the volume replaces the resource.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("volume_name")),
+			},
+		},
+		"volume_name": schema.StringAttribute{
+			Description: "The name of the volume." +
+				" Conflicts with 'volume_id'." +
+				" Changing the volume replaces the resource.",
+			Optional: true,
+			Computed: true,
+			MarkdownDescription: "The name of the volume." +
+				" Conflicts with `volume_id`." +
+				" Changing the volume replaces the resource.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"sdc_list": schema.SetNestedAttribute{
+			Description: "List of SDCs mapped to the volume. Exactly one of 'sdc_id', 'sdc_name', 'sdc_ip' and 'sdc_guid' is required for each SDC." +
+				" An empty list unmaps the volume from all the SDCs.",
+			Required: true,
+			MarkdownDescription: "List of SDCs mapped to the volume. Exactly one of `sdc_id`, `sdc_name`, `sdc_ip` and `sdc_guid` is required for each SDC." +
+				" An empty list unmaps the volume from all the SDCs.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"sdc_id": schema.StringAttribute{
+						Description:         "The ID of the SDC.",
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The ID of the SDC.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("sdc_name"),
+								path.MatchRelative().AtParent().AtName("sdc_ip"),
+								path.MatchRelative().AtParent().AtName("sdc_guid"),
+							),
+						},
+					},
+					"sdc_name": schema.StringAttribute{
+						Description:         "The name of the SDC.",
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The name of the SDC.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"sdc_ip": schema.StringAttribute{
+						Description:         "The IP address of the SDC.",
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The IP address of the SDC.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"sdc_guid": schema.StringAttribute{
+						Description:         "The GUID of the SDC.",
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The GUID of the SDC.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"limit_iops": schema.Int64Attribute{
+						Description:         "IOPS limit. Valid values are 0 or integers greater than 10. '0' represents unlimited IOPS. Default value is '0'.",
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "IOPS limit. Valid values are 0 or integers greater than 10. `0` represents unlimited IOPS. Default value is `0`.",
+					},
+					"limit_bw_in_mbps": schema.Int64Attribute{
+						Description:         "Bandwidth limit in MBPS. '0' represents unlimited bandwith. Default value is '0'.",
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Bandwidth limit in MBPS. `0` represents unlimited bandwith. Default value is `0`.",
+					},
+					"access_mode": schema.StringAttribute{
+						Description:         "The Access Mode of the SDC. Valid values are 'ReadOnly', 'ReadWrite' and 'NoAccess'. Default value is 'ReadOnly'.",
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "The Access Mode of the SDC. Valid values are `ReadOnly`, `ReadWrite` and `NoAccess`. Default value is `ReadOnly`.",
+						Validators: []validator.String{stringvalidator.OneOf(
+							"ReadOnly",
+							"ReadWrite",
+							"NoAccess",
+						)},
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var createVolumeSdcMappingVolume = `
+resource "powerflex_volume" "datastore" {
+	name = "tfacc-datastore"
+	protection_domain_name = "domain1"
+	storage_pool_name = "pool1"
+	size = 8
+	access_mode = "ReadWrite"
+}
+`
+
+func TestAccVolumeSdcMappingResource(t *testing.T) {
+	var createVolumeSdcMappingPosTest = createVolumeSdcMappingVolume + `
+	resource "powerflex_volume_sdc_mapping" "datastore" {
+		volume_name = powerflex_volume.datastore.name
+		sdc_list = [
+			{
+				sdc_id = "` + SDCMappingResourceID2 + `"
+				access_mode = "ReadWrite"
+			},
+			{
+				sdc_ip = "` + SdsResourceTestData.SdcIP + `"
+				limit_iops = 140
+				limit_bw_in_mbps = 19
+			}
+		]
+	}
+	`
+
+	var updateVolumeSdcMappingPosTest = createVolumeSdcMappingVolume + `
+	resource "powerflex_volume_sdc_mapping" "datastore" {
+		volume_id = powerflex_volume.datastore.id
+		sdc_list = [
+			{
+				sdc_name = "` + SdsResourceTestData.sdcName2 + `"
+				access_mode = "ReadOnly"
+				limit_iops = 120
+				limit_bw_in_mbps = 25
+			}
+		]
+	}
+	`
+
+	var unmapVolumeSdcMappingPosTest = createVolumeSdcMappingVolume + `
+	resource "powerflex_volume_sdc_mapping" "datastore" {
+		volume_id = powerflex_volume.datastore.id
+		sdc_list = []
+	}
+	`
+
+	var duplicateSdcVolumeSdcMappingNegTest = createVolumeSdcMappingVolume + `
+	resource "powerflex_volume_sdc_mapping" "datastore" {
+		volume_id = powerflex_volume.datastore.id
+		sdc_list = [
+			{
+				sdc_id = "` + SDCMappingResourceID2 + `"
+			},
+			{
+				sdc_name = "` + SdsResourceTestData.sdcName2 + `"
+			}
+		]
+	}
+	`
+
+	var invalidSdcVolumeSdcMappingNegTest = createVolumeSdcMappingVolume + `
+	resource "powerflex_volume_sdc_mapping" "datastore" {
+		volume_id = powerflex_volume.datastore.id
+		sdc_list = [
+			{
+				sdc_name = "tfacc-invalid-sdc"
+			}
+		]
+	}
+	`
+
+	var conflictingSdcVolumeSdcMappingNegTest = `
+	resource "powerflex_volume_sdc_mapping" "datastore" {
+		volume_id = "volume-id"
+		sdc_list = [
+			{
+				sdc_id = "sdc-id"
+				sdc_ip = "10.10.10.10"
+			}
+		]
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + conflictingSdcVolumeSdcMappingNegTest,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + createVolumeSdcMappingPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("powerflex_volume_sdc_mapping.datastore", "id", "powerflex_volume.datastore", "id"),
+					resource.TestCheckResourceAttrPair("powerflex_volume_sdc_mapping.datastore", "volume_id", "powerflex_volume.datastore", "id"),
+					resource.TestCheckResourceAttr("powerflex_volume_sdc_mapping.datastore", "sdc_list.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerflex_volume_sdc_mapping.datastore", "sdc_list.*", map[string]string{
+						"sdc_id":           SDCMappingResourceID2,
+						"sdc_name":         SdsResourceTestData.sdcName2,
+						"access_mode":      "ReadWrite",
+						"limit_iops":       "0",
+						"limit_bw_in_mbps": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("powerflex_volume_sdc_mapping.datastore", "sdc_list.*", map[string]string{
+						"sdc_ip":           SdsResourceTestData.SdcIP,
+						"access_mode":      "ReadOnly",
+						"limit_iops":       "140",
+						"limit_bw_in_mbps": "19",
+					}),
+				),
+			},
+			// check that import is working
+			{
+				ResourceName:      "powerflex_volume_sdc_mapping.datastore",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      ProviderConfigForTesting + duplicateSdcVolumeSdcMappingNegTest,
+				ExpectError: regexp.MustCompile(`.*Duplicate SDC*.`),
+			},
+			{
+				Config:      ProviderConfigForTesting + invalidSdcVolumeSdcMappingNegTest,
+				ExpectError: regexp.MustCompile(`.*Error getting SDC with name*.`),
+			},
+			{
+				Config: ProviderConfigForTesting + updateVolumeSdcMappingPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume_sdc_mapping.datastore", "sdc_list.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerflex_volume_sdc_mapping.datastore", "sdc_list.*", map[string]string{
+						"sdc_id":           SDCMappingResourceID2,
+						"access_mode":      "ReadOnly",
+						"limit_iops":       "120",
+						"limit_bw_in_mbps": "25",
+					}),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + unmapVolumeSdcMappingPosTest,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_volume_sdc_mapping.datastore", "sdc_list.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,1178 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// action runs an action of the REST API on an object and returns the body of the answer, if any.
+type action func(s *Simulator, id string, obj object, p params) (interface{}, error)
+
+// actions lists the actions supported on each object type.
+var actions = map[string]map[string]action{
+	"System": {
+		"snapshotVolumes":                 (*Simulator).snapshotVolumes,
+		"removeConsistencyGroupSnapshots": (*Simulator).removeConsistencyGroupSnapshots,
+		"approveSdc":                      (*Simulator).approveSdc,
+		"queryMdmCluster":                 (*Simulator).queryMdmCluster,
+		"addStandbyMdm":                   (*Simulator).addStandbyMdm,
+		"removeStandbyMdm":                (*Simulator).removeStandbyMdm,
+		"switchClusterMode":               (*Simulator).switchClusterMode,
+		"changeMdmOwnership":              (*Simulator).changeMdmOwnership,
+		"renameMdm":                       (*Simulator).renameMdm,
+
+		"setRestrictedSdcMode":       (*Simulator).setRestrictedSdcMode,
+		"setApprovedSdcIps":          (*Simulator).setApprovedSdcIps,
+		"setCapacityAlertThresholds": setCapacityAlertThresholds("capacityAlertHighThresholdPercent", "capacityAlertCriticalThresholdPercent"),
+		"setCliPasswordAllowed":      setBool("cliPasswordAllowed", "cliPasswordAllowed"),
+	},
+	"ProtectionDomain": {
+		"setProtectionDomainName":        rename("ProtectionDomain", "name", nil),
+		"activateProtectionDomain":       setValue("protectionDomainState", "Active"),
+		"inactivateProtectionDomain":     setValue("protectionDomainState", "Inactive"),
+		"enableSdsRfcache":               setValue("rfcacheEnabled", true),
+		"disableSdsRfcache":              setValue("rfcacheEnabled", false),
+		"enableFglMetadataCache":         setValue("fglMetadataCacheEnabled", true),
+		"disableFglMetadataCache":        setValue("fglMetadataCacheEnabled", false),
+		"setDefaultFglMetadataCacheSize": setInt("fglDefaultMetadataCacheSize", "cacheSizeInMB"),
+		"setRfcacheParameters":           (*Simulator).setRfcacheParameters,
+		"setSdsNetworkLimits":            (*Simulator).setSdsNetworkLimits,
+		"removeProtectionDomain":         (*Simulator).removeProtectionDomain,
+	},
+	"StoragePool": {
+		"setStoragePoolName":                          (*Simulator).renameStoragePool,
+		"setMediaType":                                setString("mediaType", "mediaType"),
+		"setUseRmcache":                               setBool("useRmcache", "useRmcache"),
+		"enableRfcache":                               setValue("useRfcache", true),
+		"disableRfcache":                              setValue("useRfcache", false),
+		"setZeroPaddingPolicy":                        setBool("zeroPaddingEnabled", "zeroPadEnabled"),
+		"setReplicationJournalCapacity":               setInt("replicationCapacityMaxRatio", "replicationJournalCapacityMaxRatio"),
+		"setCapacityAlertThresholds":                  setCapacityAlertThresholds("capacityAlertHighThreshold", "capacityAlertCriticalThreshold"),
+		"setProtectedMaintenanceModeIoPriorityPolicy": setIoPriorityPolicy("protectedMaintenanceModeIoPriority"),
+		"setRebalanceIoPriorityPolicy":                setIoPriorityPolicy("rebalanceIoPriority"),
+		"setVTreeMigrationIoPriorityPolicy":           setIoPriorityPolicy("vtreeMigrationIoPriority"),
+		"setRebalanceEnabled":                         setBool("rebalanceEnabled", "rebalanceEnabled"),
+		"setRebuildEnabled":                           setBool("rebuildEnabled", "rebuildEnabled"),
+		"setSparePercentage":                          setInt("sparePercentage", "sparePercentage"),
+		"setRmcacheWriteHandlingMode":                 setString("rmcacheWriteHandlingMode", "rmcacheWriteHandlingMode"),
+		"setRebuildRebalanceParallelism":              setInt("numOfParallelRebuildRebalanceJobsPerDevice", "limit"),
+		"enableFragmentation":                         setValue("fragmentationEnabled", true),
+		"disableFragmentation":                        setValue("fragmentationEnabled", false),
+		"removeStoragePool":                           (*Simulator).removeStoragePool,
+	},
+	"AccelerationPool": {
+		"setAccelerationPoolName": rename("AccelerationPool", "newName", func(obj object) interface{} { return obj["protectionDomainId"] }),
+		"removeAccelerationPool":  (*Simulator).removeAccelerationPool,
+	},
+	"FaultSet": {
+		"setFaultSetName": rename("FaultSet", "newName", func(obj object) interface{} { return obj["protectionDomainId"] }),
+		"removeFaultSet":  (*Simulator).removeFaultSet,
+	},
+	"Sds": {
+		"setSdsName":                  rename("Sds", "name", nil),
+		"setSdsPort":                  setInt("port", "sdsPort"),
+		"setDrlMode":                  setString("drlMode", "drlMode"),
+		"enableRfcache":               setValue("rfcacheEnabled", true),
+		"disableRfcache":              setValue("rfcacheEnabled", false),
+		"setSdsRmcacheEnabled":        setBool("rmcacheEnabled", "rmcacheEnabled"),
+		"setSdsRmcacheSize":           (*Simulator).setSdsRmcacheSize,
+		"setSdsPerformanceParameters": setString("perfProfile", "perfProfile"),
+		"addSdsIp":                    (*Simulator).addSdsIP,
+		"removeSdsIp":                 (*Simulator).removeSdsIP,
+		"setSdsIpRole":                (*Simulator).setSdsIPRole,
+		"removeSds":                   (*Simulator).removeSds,
+	},
+	"Device": {
+		"setDeviceName":                rename("Device", "newName", func(obj object) interface{} { return obj["sdsId"] }),
+		"setMediaType":                 (*Simulator).setDeviceMediaType,
+		"setExternalAccelerationType":  setString("externalAccelerationType", "externalAccelerationType"),
+		"setDeviceCapacityLimit":       (*Simulator).setDeviceCapacityLimit,
+		"updateDeviceOriginalPathname": (*Simulator).updateDeviceOriginalPathname,
+		"removeDevice":                 remove("Device"),
+	},
+	"Volume": {
+		"setVolumeName":              rename("Volume", "newName", nil),
+		"setVolumeSize":              (*Simulator).setVolumeSize,
+		"setVolumeAccessModeLimit":   setString("accessModeLimit", "accessModeLimit"),
+		"setVolumeUseRmcache":        setBool("useRmcache", "useRmcache"),
+		"modifyCompressionMethod":    (*Simulator).modifyCompressionMethod,
+		"setSnapshotSecurity":        (*Simulator).setSnapshotSecurity,
+		"lockAutoSnapshot":           (*Simulator).lockAutoSnapshot,
+		"unlockAutoSnapshot":         setValue("lockedAutoSnapshot", false),
+		"addMappedSdc":               (*Simulator).addMappedSdc,
+		"removeMappedSdc":            (*Simulator).removeMappedSdc,
+		"setMappedSdcLimits":         (*Simulator).setMappedSdcLimits,
+		"setVolumeMappingAccessMode": (*Simulator).setVolumeMappingAccessMode,
+		"removeVolume":               (*Simulator).removeVolume,
+		"migrateVTree":               (*Simulator).migrateVTree,
+		"overwriteVolumeContent":     (*Simulator).overwriteVolumeContent,
+	},
+	"Sdc": {
+		"setSdcName":                  rename("Sdc", "sdcName", nil),
+		"setSdcPerformanceParameters": setString("perfProfile", "perfProfile"),
+		"removeSdc":                   (*Simulator).removeSdc,
+	},
+	"SnapshotPolicy": {
+		"renameSnapshotPolicy":                 rename("SnapshotPolicy", "newName", nil),
+		"modifySnapshotPolicy":                 (*Simulator).modifySnapshotPolicy,
+		"pauseSnapshotPolicy":                  setValue("snapshotPolicyState", "Paused"),
+		"resumeSnapshotPolicy":                 setValue("snapshotPolicyState", "Active"),
+		"addSourceVolumeToSnapshotPolicy":      (*Simulator).addSourceVolumeToSnapshotPolicy,
+		"removeSourceVolumeFromSnapshotPolicy": (*Simulator).removeSourceVolumeFromSnapshotPolicy,
+		"removeSnapshotPolicy":                 (*Simulator).removeSnapshotPolicy,
+	},
+	"PeerMdm": {
+		"modifyPeerMdmName":                  rename("PeerMdm", "newName", nil),
+		"modifyPeerMdmIp":                    (*Simulator).modifyPeerMdmIP,
+		"modifyPeerMdmPort":                  setInt("port", "newPort"),
+		"modifyPeerMdmPerformanceParameters": setString("perfProfile", "perfProfile"),
+		"removePeerMdm":                      (*Simulator).removePeerMdm,
+	},
+	"ReplicationConsistencyGroup": {
+		"renameReplicationConsistencyGroup":                       rename("ReplicationConsistencyGroup", "newName", nil),
+		"modifyReplicationConsistencyGroupRpo":                    (*Simulator).modifyReplicationConsistencyGroupRpo,
+		"modifyReplicationConsistencyGroupTargetVolumeAccessMode": (*Simulator).modifyTargetVolumeAccessMode,
+		"activateReplicationConsistencyGroup":                     setActivityState("Active"),
+		"terminateReplicationConsistencyGroup":                    setActivityState("Inactive"),
+		"pauseReplicationConsistencyGroup":                        (*Simulator).pauseReplicationConsistencyGroup,
+		"resumeReplicationConsistencyGroup":                       setValue("pauseMode", "None"),
+		"failoverReplicationConsistencyGroup":                     failover("Failover"),
+		"switchoverReplicationConsistencyGroup":                   failover("Switchover"),
+		"restoreReplicationConsistencyGroup":                      failback(false),
+		"reverseReplicationConsistencyGroup":                      failback(true),
+		"freezeApplyReplicationConsistencyGroup":                  setValue("freezeState", "Frozen"),
+		"unfreezeApplyReplicationConsistencyGroup":                setValue("freezeState", "Unfrozen"),
+		"removeReplicationConsistencyGroup":                       (*Simulator).removeReplicationConsistencyGroup,
+	},
+	"ReplicationPair": {
+		"removeReplicationPair": remove("ReplicationPair"),
+	},
+	"User": {
+		"setUserRole":   (*Simulator).setUserRole,
+		"resetPassword": (*Simulator).resetPassword,
+		"removeUser":    (*Simulator).removeUser,
+	},
+}
+
+// setValue returns an action setting a field to a fixed value.
+func setValue(field string, value interface{}) action {
+	return func(_ *Simulator, _ string, obj object, _ params) (interface{}, error) {
+		obj[field] = value
+		return nil, nil
+	}
+}
+
+// setString returns an action setting a field to the value of a parameter.
+func setString(field, param string) action {
+	return func(_ *Simulator, _ string, obj object, p params) (interface{}, error) {
+		obj[field] = p.str(param)
+		return nil, nil
+	}
+}
+
+// setBool returns an action setting a boolean field to the value of a parameter.
+func setBool(field, param string) action {
+	return func(_ *Simulator, _ string, obj object, p params) (interface{}, error) {
+		obj[field] = p.boolean(param)
+		return nil, nil
+	}
+}
+
+// setInt returns an action setting an integer field to the value of a parameter.
+func setInt(field, param string) action {
+	return func(_ *Simulator, _ string, obj object, p params) (interface{}, error) {
+		value, err := p.integer(param)
+		if err != nil {
+			return nil, err
+		}
+		obj[field] = value
+		return nil, nil
+	}
+}
+
+// rename returns an action renaming an object, the name being unique among the objects with the same scope.
+func rename(objectType, param string, scope func(object) interface{}) action {
+	return func(s *Simulator, id string, obj object, p params) (interface{}, error) {
+		name := p.str(param)
+		var inScope func(object) bool
+		if scope != nil {
+			inScope = func(other object) bool { return scope(other) == scope(obj) }
+		}
+		if obj["name"] != name {
+			if err := s.checkNewName(objectType, name, inScope); err != nil {
+				return nil, err
+			}
+		}
+		obj["name"] = name
+		return nil, nil
+	}
+}
+
+// remove returns an action removing an object.
+func remove(objectType string) action {
+	return func(s *Simulator, id string, _ object, _ params) (interface{}, error) {
+		delete(s.objects[objectType], id)
+		return nil, nil
+	}
+}
+
+// setIoPriorityPolicy returns an action setting one of the IO priority policies of a storage pool.
+func setIoPriorityPolicy(prefix string) action {
+	return func(_ *Simulator, _ string, obj object, p params) (interface{}, error) {
+		concurrentIos, err := p.integer("numOfConcurrentIosPerDevice")
+		if err != nil {
+			return nil, err
+		}
+		bandwidth, err := p.integer("bwLimitPerDeviceInKbps")
+		if err != nil {
+			return nil, err
+		}
+		obj[prefix+"Policy"] = p.str("policy")
+		if concurrentIos != 0 {
+			obj[prefix+"NumOfConcurrentIosPerDevice"] = concurrentIos
+		}
+		if bandwidth != 0 {
+			obj[prefix+"BwLimitPerDeviceInKbps"] = bandwidth
+		}
+		return nil, nil
+	}
+}
+
+func (s *Simulator) snapshotVolumes(_ string, _ object, p params) (interface{}, error) {
+	defs := p.list("snapshotDefs")
+	if len(defs) == 0 {
+		return nil, errors.New("At least one snapshot definition is required")
+	}
+	retention, err := p.integer("retentionPeriodInMin")
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]object, 0, len(defs))
+	names := map[string]bool{}
+	for _, def := range defs {
+		volume, err := s.get("Volume", def.str("volumeId"))
+		if err != nil {
+			return nil, err
+		}
+		name := def.str("snapshotName")
+		if err := s.checkNewName("Volume", name, nil); err != nil {
+			return nil, err
+		}
+		if name != "" && names[name] {
+			return nil, errors.New("Volume name already in use")
+		}
+		names[name] = true
+		snapshot := object{}
+		for field, value := range volume {
+			snapshot[field] = value
+		}
+		accessMode := p.str("accessModeLimit")
+		if accessMode == "" {
+			accessMode = "ReadOnly"
+		}
+		snapshot["id"] = ""
+		snapshot["name"] = name
+		snapshot["volumeType"] = "Snapshot"
+		snapshot["ancestorVolumeId"] = volume["id"]
+		snapshot["snplIdOfSourceVolume"] = ""
+		snapshot["mappedSdcInfo"] = []object{}
+		snapshot["accessModeLimit"] = accessMode
+		snapshot["lockedAutoSnapshot"] = false
+		snapshot["secureSnapshotExpTime"] = 0
+		snapshot["creationTime"] = int(time.Now().Unix())
+		if retention > 0 {
+			snapshot["secureSnapshotExpTime"] = int(time.Now().Add(time.Duration(retention) * time.Minute).Unix())
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	s.lastID++
+	groupID := fmt.Sprintf("5a5c%012x", s.lastID)
+	ids := make([]string, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		snapshot["consistencyGroupId"] = groupID
+		ids = append(ids, s.add("Volume", snapshot))
+	}
+	return map[string]interface{}{"volumeIdList": ids, "snapshotGroupId": groupID}, nil
+}
+
+// removeConsistencyGroupSnapshots removes all the snapshots of a snapshot group, none is removed when one is mapped.
+func (s *Simulator) removeConsistencyGroupSnapshots(_ string, _ object, p params) (interface{}, error) {
+	var ids []string
+	for id, volume := range s.objects["Volume"] {
+		if volume["consistencyGroupId"] != p.str("snapGroupId") {
+			continue
+		}
+		if len(volume["mappedSdcInfo"].([]object)) > 0 {
+			return nil, errors.New("The snapshot group has snapshots mapped to SDCs and cannot be removed")
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("Could not find the snapshot group")
+	}
+	for _, id := range ids {
+		delete(s.objects["Volume"], id)
+	}
+	return map[string]interface{}{"numberOfVolumes": len(ids)}, nil
+}
+
+// approveSdc approves an SDC by GUID or by IP, depending on the restricted SDC mode of the system.
+// An SDC which is not connected yet is pre-approved, so that it can connect later.
+func (s *Simulator) approveSdc(_ string, system object, p params) (interface{}, error) {
+	guid, ip := p.str("sdcGuid"), p.str("sdcIp")
+	field, value, mode := "sdcGuid", guid, "Guid"
+	if guid == "" {
+		field, value, mode = "sdcIp", ip, "ApprovedIp"
+	}
+	switch {
+	case (guid == "") == (ip == ""):
+		return nil, errors.New("Either the SDC GUID or the SDC IP is required")
+	case system["restrictedSdcMode"] != mode:
+		return nil, fmt.Errorf("The system is not in %s restricted SDC mode", mode)
+	}
+	sdc := s.find("Sdc", field, value)
+	if sdc != nil && sdc["sdcApproved"] == true {
+		return nil, errors.New("The SDC is already approved.")
+	}
+	if err := s.checkNewName("Sdc", p.str("name"), nil); err != nil {
+		return nil, err
+	}
+	if sdc == nil {
+		sdc = object{
+			field:                value,
+			"systemId":           system["id"],
+			"mdmConnectionState": "Disconnected",
+			"perfProfile":        "HighPerformance",
+			"sdcApprovedIps":     []string{},
+		}
+		s.add("Sdc", sdc)
+	}
+	if ip != "" {
+		sdc["sdcApprovedIps"] = []string{ip}
+	}
+	if p.str("name") != "" {
+		sdc["name"] = p.str("name")
+	}
+	sdc["sdcApproved"] = true
+	return map[string]interface{}{"id": sdc["id"]}, nil
+}
+
+func (s *Simulator) setRestrictedSdcMode(_ string, system object, p params) (interface{}, error) {
+	mode := p.str("restrictedSdcMode")
+	if mode != "None" && mode != "Guid" && mode != "ApprovedIp" {
+		return nil, fmt.Errorf("Invalid restricted SDC mode %q", mode)
+	}
+	system["restrictedSdcMode"] = mode
+	system["restrictedSdcModeEnabled"] = mode != "None"
+	return nil, nil
+}
+
+func (s *Simulator) setApprovedSdcIps(_ string, _ object, p params) (interface{}, error) {
+	sdc, err := s.get("Sdc", p.str("sdcId"))
+	if err != nil {
+		return nil, err
+	}
+	sdc["sdcApprovedIps"] = p.strs("sdcIps")
+	return nil, nil
+}
+
+// clusterModes maps the modes of the MDM cluster to the number of secondary MDMs and tie-breakers they require.
+var clusterModes = map[string]int{
+	"OneNode":    0,
+	"ThreeNodes": 1,
+	"FiveNodes":  2,
+}
+
+// mdmsWithRole returns the members of the MDM cluster with the given role in the cluster, sorted by ID.
+func (s *Simulator) mdmsWithRole(clusterRole string) []object {
+	return s.list("Mdm", func(mdm object) bool { return mdm["clusterRole"] == clusterRole })
+}
+
+func (s *Simulator) queryMdmCluster(id string, system object, _ params) (interface{}, error) {
+	secondaries := s.mdmsWithRole("Secondary")
+	tieBreakers := s.mdmsWithRole("TieBreaker")
+	var primary object
+	for _, mdm := range s.mdmsWithRole("Primary") {
+		primary = mdm
+	}
+	return object{
+		"id":              id,
+		"name":            system["name"],
+		"clusterState":    system["mdmClusterState"],
+		"clusterMode":     system["mdmMode"],
+		"goodNodesNum":    1 + len(secondaries) + len(tieBreakers),
+		"goodReplicasNum": 1 + len(secondaries),
+		"master":          primary,
+		"slaves":          secondaries,
+		"tieBreakers":     tieBreakers,
+		"standbyMDMs":     s.mdmsWithRole("Standby"),
+	}, nil
+}
+
+func (s *Simulator) addStandbyMdm(_ string, _ object, p params) (interface{}, error) {
+	mdm, err := s.newMdm(p, "Standby")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"id": s.add("Mdm", mdm)}, nil
+}
+
+// clusterMdm returns a member of the MDM cluster with the given role in the cluster.
+func (s *Simulator) clusterMdm(id, clusterRole string) (object, error) {
+	mdm, err := s.get("Mdm", id)
+	if err != nil {
+		return nil, err
+	}
+	if mdm["clusterRole"] != clusterRole {
+		return nil, fmt.Errorf("The MDM %s is not a %s MDM", id, strings.ToLower(clusterRole))
+	}
+	return mdm, nil
+}
+
+func (s *Simulator) removeStandbyMdm(_ string, _ object, p params) (interface{}, error) {
+	if _, err := s.clusterMdm(p.str("id"), "Standby"); err != nil {
+		return nil, err
+	}
+	delete(s.objects["Mdm"], p.str("id"))
+	return nil, nil
+}
+
+func (s *Simulator) switchClusterMode(_ string, system object, p params) (interface{}, error) {
+	mode := p.str("mode")
+	required, ok := clusterModes[mode]
+	if !ok {
+		return nil, fmt.Errorf("Invalid cluster mode %q", mode)
+	}
+	if mode == system["mdmMode"] {
+		return nil, fmt.Errorf("The MDM cluster is already in %s mode", mode)
+	}
+	// check all the members before changing any of them
+	changes := map[string]string{}
+	for _, change := range []struct{ param, from, to, role string }{
+		{"addSecondaryMdms", "Standby", "Secondary", "Manager"},
+		{"removeSecondaryMdms", "Secondary", "Standby", "Manager"},
+		{"addTBs", "Standby", "TieBreaker", "TieBreaker"},
+		{"removeTBs", "TieBreaker", "Standby", "TieBreaker"},
+	} {
+		for _, id := range p.strs(change.param) {
+			mdm, err := s.clusterMdm(id, change.from)
+			if err != nil {
+				return nil, err
+			}
+			if mdm["role"] != change.role {
+				return nil, fmt.Errorf("The MDM %s does not have the %s role", id, change.role)
+			}
+			changes[id] = change.to
+		}
+	}
+	count := map[string]int{}
+	for _, mdm := range s.objects["Mdm"] {
+		clusterRole := mdm["clusterRole"].(string)
+		if to, ok := changes[mdm["id"].(string)]; ok {
+			clusterRole = to
+		}
+		count[clusterRole]++
+	}
+	if count["Secondary"] != required || count["TieBreaker"] != required {
+		return nil, fmt.Errorf("The %s mode requires %d secondary MDMs and %d tie-breakers", mode, required, required)
+	}
+	for id, to := range changes {
+		s.objects["Mdm"][id]["clusterRole"] = to
+	}
+	system["mdmMode"] = mode
+	s.syncMdmCluster(system)
+	return nil, nil
+}
+
+func (s *Simulator) changeMdmOwnership(_ string, system object, p params) (interface{}, error) {
+	secondary, err := s.clusterMdm(p.str("id"), "Secondary")
+	if err != nil {
+		return nil, err
+	}
+	for _, mdm := range s.objects["Mdm"] {
+		if mdm["clusterRole"] == "Primary" {
+			mdm["clusterRole"] = "Secondary"
+		}
+	}
+	secondary["clusterRole"] = "Primary"
+	s.syncMdmCluster(system)
+	return nil, nil
+}
+
+func (s *Simulator) renameMdm(_ string, _ object, p params) (interface{}, error) {
+	mdm, err := s.get("Mdm", p.str("id"))
+	if err != nil {
+		return nil, err
+	}
+	return rename("Mdm", "newName", nil)(s, p.str("id"), mdm, p)
+}
+
+// syncMdmCluster updates the IPs of the MDM cluster members the system reports.
+func (s *Simulator) syncMdmCluster(system object) {
+	ips := func(clusterRole string) []string {
+		list := []string{}
+		for _, mdm := range s.mdmsWithRole(clusterRole) {
+			list = append(list, mdm["ips"].([]string)...)
+		}
+		return list
+	}
+	managementIPs := []string{}
+	for _, clusterRole := range []string{"Primary", "Secondary"} {
+		for _, mdm := range s.mdmsWithRole(clusterRole) {
+			managementIPs = append(managementIPs, mdm["managementIPs"].([]string)...)
+		}
+	}
+	system["primaryMdmActorIpList"] = ips("Primary")
+	system["secondaryMdmActorIpList"] = ips("Secondary")
+	system["tiebreakerMdmIpList"] = ips("TieBreaker")
+	system["mdmManagementIPList"] = managementIPs
+}
+
+// isPowerOfTwo reports whether the value is a power of 2 between the bounds.
+func isPowerOfTwo(value, min, max int) bool {
+	return value >= min && value <= max && value&(value-1) == 0
+}
+
+func (s *Simulator) setRfcacheParameters(_ string, obj object, p params) (interface{}, error) {
+	pageSize, err := p.integer("pageSizeKb")
+	if err != nil {
+		return nil, err
+	}
+	maxIOSize, err := p.integer("maxIOSizeKb")
+	if err != nil {
+		return nil, err
+	}
+	if p.has("pageSizeKb") && !isPowerOfTwo(pageSize, 4, 64) {
+		return nil, errors.New("Invalid RFcache page size. Valid values are powers of 2 between 4KB and 64KB")
+	}
+	if p.has("maxIOSizeKb") && !isPowerOfTwo(maxIOSize, 16, 128) {
+		return nil, errors.New("Invalid RFcache max IO size. Valid values are powers of 2 between 16KB and 128KB")
+	}
+	if p.has("pageSizeKb") {
+		obj["rfcachePageSizeKb"] = pageSize
+	}
+	if p.has("maxIOSizeKb") {
+		obj["rfcacheMaxIoSizeKb"] = maxIOSize
+	}
+	if p.has("rfcacheOperationMode") {
+		obj["rfcacheOpertionalMode"] = p.str("rfcacheOperationMode")
+	}
+	return nil, nil
+}
+
+func (s *Simulator) setSdsNetworkLimits(_ string, obj object, p params) (interface{}, error) {
+	limits := map[string]string{
+		"rebuildLimitInKbps":                  "rebuildNetworkThrottling",
+		"rebalanceLimitInKbps":                "rebalanceNetworkThrottling",
+		"vtreeMigrationLimitInKbps":           "vtreeMigrationNetworkThrottling",
+		"protectedMaintenanceModeLimitInKbps": "protectedMaintenanceModeNetworkThrottling",
+		"overallLimitInKbps":                  "overallIoNetworkThrottling",
+	}
+	for param, field := range limits {
+		if !p.has(param) {
+			continue
+		}
+		limit, err := p.integer(param)
+		if err != nil {
+			return nil, err
+		}
+		obj[field+"InKbps"] = limit
+		obj[field+"Enabled"] = limit != 0
+	}
+	return nil, nil
+}
+
+func (s *Simulator) removeProtectionDomain(id string, _ object, _ params) (interface{}, error) {
+	if len(s.related("ProtectionDomain", id, "StoragePool")) > 0 || len(s.related("ProtectionDomain", id, "Sds")) > 0 ||
+		len(s.related("ProtectionDomain", id, "FaultSet")) > 0 || len(s.related("ProtectionDomain", id, "ReplicationConsistencyGroup")) > 0 {
+		return nil, errors.New("The protection domain cannot be removed while it has storage pools, SDSs, fault sets or replication consistency groups")
+	}
+	delete(s.objects["ProtectionDomain"], id)
+	return nil, nil
+}
+
+func (s *Simulator) removeAccelerationPool(id string, _ object, _ params) (interface{}, error) {
+	if len(s.related("AccelerationPool", id, "Device")) > 0 {
+		return nil, errors.New("The acceleration pool cannot be removed while it has devices")
+	}
+	delete(s.objects["AccelerationPool"], id)
+	return nil, nil
+}
+
+func (s *Simulator) removeFaultSet(id string, _ object, _ params) (interface{}, error) {
+	if len(s.related("FaultSet", id, "Sds")) > 0 {
+		return nil, errors.New("The fault set cannot be removed while it has SDSs")
+	}
+	delete(s.objects["FaultSet"], id)
+	return nil, nil
+}
+
+func (s *Simulator) renameStoragePool(id string, obj object, p params) (interface{}, error) {
+	rename := rename("StoragePool", "name", func(pool object) interface{} { return pool["protectionDomainId"] })
+	if _, err := rename(s, id, obj, p); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"id": id}, nil
+}
+
+// setCapacityAlertThresholds returns an action setting the capacity alert thresholds of a storage pool or of the system,
+// stored in the given fields.
+func setCapacityAlertThresholds(highField, criticalField string) action {
+	return func(_ *Simulator, _ string, obj object, p params) (interface{}, error) {
+		high, critical := obj[highField].(int), obj[criticalField].(int)
+		var err error
+		if p.has("capacityAlertHighThresholdPercent") {
+			if high, err = p.integer("capacityAlertHighThresholdPercent"); err != nil {
+				return nil, err
+			}
+		}
+		if p.has("capacityAlertCriticalThresholdPercent") {
+			if critical, err = p.integer("capacityAlertCriticalThresholdPercent"); err != nil {
+				return nil, err
+			}
+		}
+		if high >= critical {
+			return nil, errors.New("The capacity alert high threshold must be lower than the critical threshold")
+		}
+		obj[highField], obj[criticalField] = high, critical
+		return nil, nil
+	}
+}
+
+func (s *Simulator) removeStoragePool(id string, _ object, _ params) (interface{}, error) {
+	if len(s.related("StoragePool", id, "Volume")) > 0 || len(s.related("StoragePool", id, "Device")) > 0 {
+		return nil, errors.New("The storage pool cannot be removed while it has volumes or devices")
+	}
+	delete(s.objects["StoragePool"], id)
+	return nil, nil
+}
+
+func (s *Simulator) setSdsRmcacheSize(_ string, obj object, p params) (interface{}, error) {
+	size, err := p.integer("rmcacheSizeInMB")
+	if err != nil {
+		return nil, err
+	}
+	if size < 128 || size > 3911 {
+		return nil, errors.New("The Read RAM Cache size must be between 128 MB and 3911 MB")
+	}
+	obj["rmcacheSizeInKb"] = size * 1024
+	return nil, nil
+}
+
+func (s *Simulator) addSdsIP(_ string, obj object, p params) (interface{}, error) {
+	ip := p.str("ip")
+	if s.sdsWithIP(ip, obj["port"].(int)) != nil {
+		return nil, errors.New("The SDS IP address and port already in use")
+	}
+	obj["ipList"] = append(obj["ipList"].([]object), object{"ip": ip, "role": p.str("role")})
+	return nil, nil
+}
+
+func (s *Simulator) removeSdsIP(_ string, obj object, p params) (interface{}, error) {
+	ipList := []object{}
+	for _, sdsIP := range obj["ipList"].([]object) {
+		if sdsIP["ip"] != p.str("ip") {
+			ipList = append(ipList, sdsIP)
+		}
+	}
+	switch len(ipList) {
+	case len(obj["ipList"].([]object)):
+		return nil, fmt.Errorf("The IP %s is not configured on the SDS", p.str("ip"))
+	case 0:
+		return nil, errors.New("The last IP address of the SDS cannot be removed")
+	}
+	obj["ipList"] = ipList
+	return nil, nil
+}
+
+func (s *Simulator) setSdsIPRole(_ string, obj object, p params) (interface{}, error) {
+	for _, sdsIP := range obj["ipList"].([]object) {
+		if sdsIP["ip"] == p.str("sdsIpToSet") {
+			sdsIP["role"] = p.str("newRole")
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("The IP %s is not configured on the SDS", p.str("sdsIpToSet"))
+}
+
+func (s *Simulator) removeSds(id string, _ object, _ params) (interface{}, error) {
+	// the data of the devices is migrated to the other SDSs before the removal
+	for deviceID, device := range s.objects["Device"] {
+		if device["sdsId"] == id {
+			delete(s.objects["Device"], deviceID)
+		}
+	}
+	delete(s.objects["Sds"], id)
+	return nil, nil
+}
+
+func (s *Simulator) setDeviceMediaType(_ string, obj object, p params) (interface{}, error) {
+	pool, err := s.get("StoragePool", obj["storagePoolId"].(string))
+	if err != nil {
+		return nil, err
+	}
+	if p.str("mediaType") != pool["mediaType"] {
+		return nil, errors.New("The device media type is not compatible with the Storage Pool media type")
+	}
+	obj["mediaType"] = p.str("mediaType")
+	return nil, nil
+}
+
+func (s *Simulator) setDeviceCapacityLimit(_ string, obj object, p params) (interface{}, error) {
+	limit, err := p.integer("capacityLimitInGB")
+	if err != nil {
+		return nil, err
+	}
+	if limit*kbPerGB > obj["maxCapacityInKb"].(int) {
+		return nil, errors.New("The capacity limit exceeds the capacity of the device")
+	}
+	obj["capacityLimitInKb"] = limit * kbPerGB
+	return nil, nil
+}
+
+func (s *Simulator) updateDeviceOriginalPathname(_ string, obj object, _ params) (interface{}, error) {
+	obj["deviceOriginalPathName"] = obj["deviceCurrentPathName"]
+	return nil, nil
+}
+
+func (s *Simulator) setVolumeSize(_ string, obj object, p params) (interface{}, error) {
+	sizeInGB, err := p.integer("sizeInGB")
+	if err != nil {
+		return nil, err
+	}
+	sizeInKb, err := volumeSize(sizeInGB * kbPerGB)
+	if err != nil {
+		return nil, err
+	}
+	if sizeInKb < obj["sizeInKb"].(int) {
+		return nil, errors.New("Volume capacity can only be increased")
+	}
+	obj["sizeInKb"] = sizeInKb
+	return nil, nil
+}
+
+func (s *Simulator) modifyCompressionMethod(_ string, obj object, p params) (interface{}, error) {
+	pool, err := s.get("StoragePool", obj["storagePoolId"].(string))
+	if err != nil {
+		return nil, err
+	}
+	method, err := s.compressionMethod(pool, p.str("compressionMethod"))
+	if err != nil {
+		return nil, err
+	}
+	obj["compressionMethod"] = method
+	return nil, nil
+}
+
+func (s *Simulator) setSnapshotSecurity(_ string, obj object, p params) (interface{}, error) {
+	if obj["volumeType"] != "Snapshot" {
+		return nil, errors.New("Only snapshots can be secured")
+	}
+	retention, err := p.integer("retentionPeriodInMin")
+	if err != nil {
+		return nil, err
+	}
+	obj["secureSnapshotExpTime"] = int(time.Now().Add(time.Duration(retention) * time.Minute).Unix())
+	return nil, nil
+}
+
+func (s *Simulator) lockAutoSnapshot(_ string, obj object, _ params) (interface{}, error) {
+	// the snapshots created through the REST API are never auto-snapshots
+	return nil, errors.New("The specified volume is not an auto-snapshot and hence cannot be locked")
+}
+
+func (s *Simulator) addMappedSdc(_ string, obj object, p params) (interface{}, error) {
+	sdc, err := s.get("Sdc", p.str("sdcId"))
+	if err != nil {
+		return nil, err
+	}
+	mappings := obj["mappedSdcInfo"].([]object)
+	if mappingOf(obj, p.str("sdcId")) != nil {
+		return nil, errors.New("The volume is already mapped to this SDC")
+	}
+	if len(mappings) > 0 && !p.boolean("allowMultipleMappings") {
+		return nil, errors.New("The volume is already mapped to another SDC, multiple mappings are not allowed")
+	}
+	accessMode := p.str("accessMode")
+	if accessMode == "" {
+		accessMode = "ReadWrite"
+	}
+	if accessMode == "ReadWrite" && obj["accessModeLimit"] == "ReadOnly" {
+		return nil, errors.New("The access mode exceeds the access mode limit of the volume")
+	}
+	obj["mappedSdcInfo"] = append(mappings, object{
+		"sdcId":         sdc["id"],
+		"sdcIp":         sdc["sdcIp"],
+		"sdcName":       sdc["name"],
+		"accessMode":    accessMode,
+		"limitIops":     0,
+		"limitBwInMbps": 0,
+	})
+	return nil, nil
+}
+
+func (s *Simulator) removeMappedSdc(_ string, obj object, p params) (interface{}, error) {
+	if p.boolean("allSdcs") {
+		obj["mappedSdcInfo"] = []object{}
+		return nil, nil
+	}
+	mappings := []object{}
+	for _, mapping := range obj["mappedSdcInfo"].([]object) {
+		if mapping["sdcId"] != p.str("sdcId") {
+			mappings = append(mappings, mapping)
+		}
+	}
+	if len(mappings) == len(obj["mappedSdcInfo"].([]object)) {
+		return nil, errors.New("The volume is not mapped to the SDC")
+	}
+	obj["mappedSdcInfo"] = mappings
+	return nil, nil
+}
+
+func (s *Simulator) setMappedSdcLimits(_ string, obj object, p params) (interface{}, error) {
+	mapping := mappingOf(obj, p.str("sdcId"))
+	if mapping == nil {
+		return nil, errors.New("The volume is not mapped to the SDC")
+	}
+	if p.has("iopsLimit") {
+		iops, err := p.integer("iopsLimit")
+		if err != nil {
+			return nil, err
+		}
+		if iops != 0 && iops <= 10 {
+			return nil, errors.New("The IOPS limit must be greater than 10, or 0 for unlimited")
+		}
+		mapping["limitIops"] = iops
+	}
+	if p.has("bandwidthLimitInKbps") {
+		bandwidth, err := p.integer("bandwidthLimitInKbps")
+		if err != nil {
+			return nil, err
+		}
+		if bandwidth%1024 != 0 {
+			return nil, errors.New("The bandwidth limit must be a multiple of 1024 Kbps")
+		}
+		mapping["limitBwInMbps"] = bandwidth / 1024
+	}
+	return nil, nil
+}
+
+func (s *Simulator) setVolumeMappingAccessMode(_ string, obj object, p params) (interface{}, error) {
+	mapping := mappingOf(obj, p.str("sdcId"))
+	if mapping == nil {
+		return nil, errors.New("The volume is not mapped to the SDC")
+	}
+	if p.str("accessMode") == "ReadWrite" && obj["accessModeLimit"] == "ReadOnly" {
+		return nil, errors.New("The access mode exceeds the access mode limit of the volume")
+	}
+	mapping["accessMode"] = p.str("accessMode")
+	return nil, nil
+}
+
+func (s *Simulator) migrateVTree(_ string, obj object, p params) (interface{}, error) {
+	vtreeID := obj["vtreeId"].(string)
+	if info := s.migrations[vtreeID]; info != nil && info["migrationStatus"] != "NotInMigration" {
+		return nil, errors.New("The VTree is already being migrated")
+	}
+	pool, err := s.get("StoragePool", p.str("destSPId"))
+	if err != nil {
+		return nil, err
+	}
+	base := s.baseVolume(vtreeID)
+	conversion := p.str("volTypeConversion")
+	switch {
+	case conversion == "" && pool["id"] == base["storagePoolId"]:
+		return nil, errors.New("The VTree is already in the destination storage pool")
+	case conversion == "ThickToThin" && base["volumeType"] != "ThickProvisioned",
+		conversion == "ThinToThick" && base["volumeType"] != "ThinProvisioned":
+		return nil, fmt.Errorf("Invalid volume type conversion %s for a %s volume", conversion, base["volumeType"])
+	case conversion != "" && conversion != "ThickToThin" && conversion != "ThinToThick":
+		return nil, fmt.Errorf("Invalid volume type conversion %s", conversion)
+	}
+	volumeType := base["volumeType"]
+	if conversion != "" {
+		volumeType = map[string]string{"ThickToThin": "ThinProvisioned", "ThinToThick": "ThickProvisioned"}[conversion]
+	}
+	if pool["dataLayout"] == "FineGranularity" && volumeType != "ThinProvisioned" {
+		return nil, errors.New("Fine granularity storage pools only support thin provisioned volumes")
+	}
+	compressionMethod, err := s.compressionMethod(pool, p.str("compressionMethod"))
+	if err != nil {
+		return nil, err
+	}
+	// the migration completes the next time the VTree is read
+	s.migrations[vtreeID] = object{
+		"sourceStoragePoolId":      base["storagePoolId"],
+		"destinationStoragePoolId": pool["id"],
+		"migrationStatus":          "MigrationNormal",
+		"migrationPauseReason":     "None",
+		"thicknessConversionType":  conversion,
+		"volumeType":               volumeType,
+		"compressionMethod":        compressionMethod,
+	}
+	return nil, nil
+}
+
+// overwriteVolumeContent overwrites a volume with another volume of its VTree,
+// the simulator does not keep the content of the volumes so it only checks the request.
+func (s *Simulator) overwriteVolumeContent(_ string, obj object, p params) (interface{}, error) {
+	source, err := s.get("Volume", p.str("srcVolumeId"))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case source["id"] == obj["id"]:
+		return nil, errors.New("You cannot overwrite a volume with itself. Select a different volume in the V-Tree and try again.")
+	case source["vtreeId"] != obj["vtreeId"]:
+		return nil, errors.New("To overwrite the content of a volume, the source and target volumes must be from the same V-Tree.")
+	}
+	return nil, nil
+}
+
+func (s *Simulator) removeVolume(id string, obj object, p params) (interface{}, error) {
+	if len(obj["mappedSdcInfo"].([]object)) > 0 {
+		return nil, errors.New("The volume is mapped to SDCs and cannot be removed")
+	}
+	if s.find("ReplicationPair", "localVolumeId", id) != nil {
+		return nil, errors.New("The volume is replicated and cannot be removed")
+	}
+	var removed []string
+	switch mode := p.str("removeMode"); mode {
+	case "", "ONLY_ME":
+		removed = []string{id}
+	case "INCLUDING_DESCENDANTS":
+		removed = append([]string{id}, s.descendants(id)...)
+	case "DESCENDANTS_ONLY":
+		removed = s.descendants(id)
+	case "WHOLE_VTREE":
+		for otherID, other := range s.objects["Volume"] {
+			if other["vtreeId"] == obj["vtreeId"] {
+				removed = append(removed, otherID)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("Invalid remove mode %s", mode)
+	}
+	for _, volumeID := range removed {
+		delete(s.objects["Volume"], volumeID)
+	}
+	return nil, nil
+}
+
+// descendants returns the IDs of the snapshots taken from a volume, directly or from its snapshots.
+func (s *Simulator) descendants(id string) []string {
+	var ids []string
+	for otherID, other := range s.objects["Volume"] {
+		if other["ancestorVolumeId"] == id {
+			ids = append(ids, otherID)
+			ids = append(ids, s.descendants(otherID)...)
+		}
+	}
+	return ids
+}
+
+func (s *Simulator) removeSdc(id string, _ object, _ params) (interface{}, error) {
+	if len(s.related("Sdc", id, "Volume")) > 0 {
+		return nil, errors.New("The SDC has mapped volumes and cannot be removed")
+	}
+	delete(s.objects["Sdc"], id)
+	return nil, nil
+}
+
+func (s *Simulator) modifySnapshotPolicy(_ string, obj object, p params) (interface{}, error) {
+	cadence, retained, err := snapshotSchedule(p)
+	if err != nil {
+		return nil, err
+	}
+	obj["autoSnapshotCreationCadenceInMin"] = cadence
+	obj["numOfRetainedSnapshotsPerLevel"] = retained
+	obj["maxVTreeAutoSnapshots"] = sum(retained)
+	return nil, nil
+}
+
+func (s *Simulator) addSourceVolumeToSnapshotPolicy(id string, obj object, p params) (interface{}, error) {
+	volume, err := s.get("Volume", p.str("sourceVolumeId"))
+	if err != nil {
+		return nil, err
+	}
+	if policyID, _ := volume["snplIdOfSourceVolume"].(string); policyID != "" {
+		return nil, errors.New("The volume is already attached to a snapshot policy")
+	}
+	volume["snplIdOfSourceVolume"] = id
+	obj["numOfSourceVolumes"] = len(s.related("SnapshotPolicy", id, "SourceVolume"))
+	return nil, nil
+}
+
+func (s *Simulator) removeSourceVolumeFromSnapshotPolicy(id string, obj object, p params) (interface{}, error) {
+	volume, err := s.get("Volume", p.str("sourceVolumeId"))
+	if err != nil {
+		return nil, err
+	}
+	if volume["snplIdOfSourceVolume"] != id {
+		return nil, errors.New("The volume is not attached to the snapshot policy")
+	}
+	switch action := p.str("autoSnapshotRemovalAction"); action {
+	case "Remove", "Detach":
+	default:
+		return nil, fmt.Errorf("Invalid auto snapshot removal action %s", action)
+	}
+	volume["snplIdOfSourceVolume"] = ""
+	obj["numOfSourceVolumes"] = len(s.related("SnapshotPolicy", id, "SourceVolume"))
+	return nil, nil
+}
+
+func (s *Simulator) removeSnapshotPolicy(id string, _ object, _ params) (interface{}, error) {
+	if len(s.related("SnapshotPolicy", id, "SourceVolume")) > 0 {
+		return nil, errors.New("The snapshot policy has source volumes and cannot be removed")
+	}
+	delete(s.objects["SnapshotPolicy"], id)
+	return nil, nil
+}
+
+func (s *Simulator) modifyPeerMdmIP(_ string, obj object, p params) (interface{}, error) {
+	ipList, err := peerMdmIPList(p.strs("newPeerMdmIps"))
+	if err != nil {
+		return nil, err
+	}
+	obj["ipList"] = ipList
+	return nil, nil
+}
+
+func (s *Simulator) removePeerMdm(id string, _ object, _ params) (interface{}, error) {
+	if len(s.related("PeerMdm", id, "ReplicationConsistencyGroup")) > 0 {
+		return nil, errors.New("The peer MDM is used by replication consistency groups and cannot be removed")
+	}
+	delete(s.objects["PeerMdm"], id)
+	return nil, nil
+}
+
+func (s *Simulator) modifyReplicationConsistencyGroupRpo(_ string, obj object, p params) (interface{}, error) {
+	rpo, err := rpoInSeconds(p)
+	if err != nil {
+		return nil, err
+	}
+	obj["rpoInSeconds"] = rpo
+	return nil, nil
+}
+
+func (s *Simulator) modifyTargetVolumeAccessMode(_ string, obj object, p params) (interface{}, error) {
+	switch mode := p.str("targetVolumeAccessMode"); mode {
+	case "NoAccess", "ReadOnly":
+		obj["targetVolumeAccessMode"] = mode
+	default:
+		return nil, fmt.Errorf("Invalid target volume access mode %s", mode)
+	}
+	return nil, nil
+}
+
+// setActivityState returns an action activating or terminating the replication of a replication consistency group.
+func setActivityState(state string) action {
+	return func(_ *Simulator, _ string, obj object, _ params) (interface{}, error) {
+		obj["localActivityState"] = state
+		obj["remoteActivityState"] = state
+		return nil, nil
+	}
+}
+
+func (s *Simulator) pauseReplicationConsistencyGroup(_ string, obj object, p params) (interface{}, error) {
+	if obj["localActivityState"] != "Active" {
+		return nil, errors.New("The replication consistency group is not active")
+	}
+	switch mode := p.str("pauseMode"); mode {
+	case "StopDataTransfer", "OnlyTrackChanges":
+		obj["pauseMode"] = mode
+	default:
+		return nil, fmt.Errorf("Invalid pause mode %s", mode)
+	}
+	return nil, nil
+}
+
+// failover returns an action failing over or switching over a replication consistency group to its remote system.
+func failover(failoverType string) action {
+	return func(_ *Simulator, _ string, obj object, _ params) (interface{}, error) {
+		if obj["failoverType"] != "None" {
+			return nil, errors.New("The replication consistency group is already failed over")
+		}
+		if failoverType == "Switchover" && obj["currConsistMode"] != "Consistent" {
+			return nil, errors.New("The replication consistency group is not consistent")
+		}
+		obj["failoverType"] = failoverType
+		obj["failoverState"] = "Done"
+		return nil, nil
+	}
+}
+
+// failback returns an action ending the failover of a replication consistency group,
+// restoring or reversing its replication direction.
+func failback(reverse bool) action {
+	return func(_ *Simulator, _ string, obj object, _ params) (interface{}, error) {
+		if obj["failoverType"] == "None" {
+			return nil, errors.New("The replication consistency group is not failed over")
+		}
+		if reverse {
+			if obj["replicationDirection"] == "LocalToRemote" {
+				obj["replicationDirection"] = "RemoteToLocal"
+			} else {
+				obj["replicationDirection"] = "LocalToRemote"
+			}
+		}
+		obj["failoverType"] = "None"
+		obj["failoverState"] = "None"
+		return nil, nil
+	}
+}
+
+func (s *Simulator) removeReplicationConsistencyGroup(id string, _ object, _ params) (interface{}, error) {
+	if len(s.related("ReplicationConsistencyGroup", id, "ReplicationPair")) > 0 {
+		return nil, errors.New("The replication consistency group cannot be removed while it has replication pairs")
+	}
+	delete(s.objects["ReplicationConsistencyGroup"], id)
+	return nil, nil
+}
+
+func (s *Simulator) setUserRole(_ string, obj object, p params) (interface{}, error) {
+	if err := checkUserRole(p.str("userRole")); err != nil {
+		return nil, err
+	}
+	if obj["name"] == s.Username {
+		return nil, errors.New("The role of the current user cannot be changed")
+	}
+	obj["userRole"] = p.str("userRole")
+	return nil, nil
+}
+
+func (s *Simulator) resetPassword(_ string, obj object, p params) (interface{}, error) {
+	if p.str("password") == "" {
+		return nil, errors.New("The password is required")
+	}
+	obj["passwordChangeRequired"] = true
+	return nil, nil
+}
+
+func (s *Simulator) removeUser(id string, obj object, _ params) (interface{}, error) {
+	if obj["name"] == s.Username {
+		return nil, errors.New("The current user cannot be removed")
+	}
+	delete(s.objects["User"], id)
+	return nil, nil
+}
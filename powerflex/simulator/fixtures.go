@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import "strconv"
+
+// The fixtures reuse the names and the IDs the acceptance tests expect to find on the system.
+// The IPs are in the 192.0.2.0/24 documentation range.
+const (
+	SystemID           = "0e7a082862fedf0f"
+	ProtectionDomainID = "202a046600000000"
+	StoragePoolID      = "c98e26e500000000"
+	FineStoragePoolID  = "c992bad600000005"
+	SdsID              = "0db7306f00000003"
+	SdcID              = "e3d01ba100000000"
+	MappingSdcID       = "e3d01ba200000001"
+	RenamedSdcID       = "e3cff47d00000005"
+	VolumeID           = "edb2a2cb00000002"
+	SnapshotPolicyID   = "896a535700000000"
+	// RemoteSystemID and RemoteProtectionDomainID identify the peer system the replication tests replicate to,
+	// the simulator does not check the remote objects.
+	RemoteSystemID           = "4a54a8ba6df0690f"
+	RemoteProtectionDomainID = "a6d2a8c700000000"
+	RemoteVolumeID           = "b0b0b0b000000001"
+)
+
+// seed adds the fixtures to the simulator.
+func (s *Simulator) seed() {
+	system := object{
+		"id":                SystemID,
+		"name":              "powerflex-simulator",
+		"systemVersionName": "DellEMC PowerFlex Version: R3_6.700.103",
+		"mdmClusterState":   "ClusteredNormal",
+		"mdmMode":           "ThreeNodes",
+
+		"restrictedSdcModeEnabled":              false,
+		"restrictedSdcMode":                     "None",
+		"capacityAlertHighThresholdPercent":     80,
+		"capacityAlertCriticalThresholdPercent": 90,
+		"cliPasswordAllowed":                    true,
+	}
+	s.add("System", system)
+	for _, member := range []struct{ name, ip, role, clusterRole string }{
+		{"mdm1", "192.0.2.1", "Manager", "Primary"},
+		{"mdm2", "192.0.2.2", "Manager", "Secondary"},
+		{"tb1", "192.0.2.3", "TieBreaker", "TieBreaker"},
+	} {
+		mdm, _ := s.newMdm(params{
+			"name":          member.name,
+			"ips":           []interface{}{member.ip},
+			"managementIps": []interface{}{member.ip},
+			"role":          member.role,
+		}, member.clusterRole)
+		s.add("Mdm", mdm)
+	}
+	s.syncMdmCluster(system)
+
+	admin, _ := s.newUser(params{"name": s.Username, "userRole": "SuperUser", "password": s.Password})
+	admin["passwordChangeRequired"] = false
+	s.add("User", admin)
+
+	pd, _ := s.newProtectionDomain(params{"name": "domain1"})
+	pd["id"] = ProtectionDomainID
+	s.add("ProtectionDomain", pd)
+
+	pool, _ := s.newStoragePool(params{"name": "pool1", "protectionDomainId": ProtectionDomainID})
+	pool["id"] = StoragePoolID
+	s.add("StoragePool", pool)
+	finePool, _ := s.newStoragePool(params{"name": "pool2", "protectionDomainId": ProtectionDomainID, "mediaType": "SSD"})
+	finePool["id"] = FineStoragePoolID
+	finePool["dataLayout"] = "FineGranularity"
+	s.add("StoragePool", finePool)
+
+	for i, name := range []string{"SDS_1", "SDS_2", "SDS_3"} {
+		sds, _ := s.newSds(params{
+			"name":               name,
+			"protectionDomainId": ProtectionDomainID,
+			"sdsIpList":          []interface{}{map[string]interface{}{"ip": "192.0.2." + strconv.Itoa(4+i), "role": "all"}},
+		})
+		if name == "SDS_2" {
+			sds["id"] = SdsID
+		}
+		sdsID := s.add("Sds", sds)
+		device, _ := s.newDevice(params{
+			"name":                  "device-" + name,
+			"deviceCurrentPathname": "/dev/sdb",
+			"storagePoolId":         StoragePoolID,
+			"sdsId":                 sdsID,
+		})
+		s.add("Device", device)
+	}
+
+	for _, sdc := range []object{
+		{"id": SdcID, "name": "Terraform_sdc1", "sdcIp": "192.0.2.10", "sdcGuid": "6F1D1A70-3A1E-4D26-9E24-0B2D8C1F0001"},
+		{"id": MappingSdcID, "name": "terraform_sdc", "sdcIp": "192.0.2.11", "sdcGuid": "6F1D1A70-3A1E-4D26-9E24-0B2D8C1F0002"},
+		{"id": RenamedSdcID, "name": "sdc_to_rename", "sdcIp": "192.0.2.12", "sdcGuid": "6F1D1A70-3A1E-4D26-9E24-0B2D8C1F0003"},
+	} {
+		sdc["systemId"] = SystemID
+		sdc["sdcApproved"] = true
+		sdc["mdmConnectionState"] = "Connected"
+		sdc["perfProfile"] = "HighPerformance"
+		sdc["osType"] = "Linux"
+		sdc["sdcApprovedIps"] = []string{}
+		s.add("Sdc", sdc)
+	}
+
+	for _, name := range []string{"tf-unknown-test-donot-delete", "tf-volume-2", "tf-volume-3"} {
+		volume, _ := s.newVolume(params{"name": name, "storagePoolId": StoragePoolID, "volumeSizeInKb": "8388608"})
+		if name == "tf-unknown-test-donot-delete" {
+			volume["id"] = VolumeID
+		}
+		s.add("Volume", volume)
+	}
+
+	for id, name := range map[string]string{SnapshotPolicyID: "sample_snap_policy_1", "896a535800000001": "sample_snap_policy"} {
+		policy, _ := s.newSnapshotPolicy(params{
+			"name":                             name,
+			"autoSnapshotCreationCadenceInMin": "60",
+			"numOfRetainedSnapshotsPerLevel":   []interface{}{"4"},
+		})
+		policy["id"] = id
+		s.add("SnapshotPolicy", policy)
+	}
+}
+
+// Environment returns the variables configuring the acceptance tests to run against the simulator
+// started at the given endpoint.
+func (s *Simulator) Environment(endpoint string) map[string]string {
+	env := map[string]string{
+		"POWERFLEX_ENDPOINT":                  endpoint,
+		"POWERFLEX_USERNAME":                  s.Username,
+		"POWERFLEX_PASSWORD":                  s.Password,
+		"POWERFLEX_INSECURE":                  "true",
+		"POWERFLEX_PROTECTION_DOMAIN_ID":      ProtectionDomainID,
+		"POWERFLEX_STORAGE_POOL_NAME":         "terraform-pool",
+		"POWERFLEX_DEVICE_SDS_ID":             SdsID,
+		"POWERFLEX_SDC_IP":                    "192.0.2.10",
+		"POWERFLEX_SDC_IP1":                   "192.0.2.10",
+		"POWERFLEX_SDC_NAME":                  "Terraform_sdc1",
+		"POWERFLEX_SDC_NAME_2":                "terraform_sdc",
+		"POWERFLEX_SDC_NAME_3":                "sdc_to_rename",
+		"POWERFLEX_VOLUME_NAME":               "tf-unknown-test-donot-delete",
+		"POWERFLEX_VOLUME_NAME_2":             "tf-volume-2",
+		"POWERFLEX_VOLUME_NAME_3":             "tf-volume-3",
+		"POWERFLEX_SDC_VOLUMES_MAPPING_NAME":  "tf-unknown-test-donot-delete",
+		"POWERFLEX_SDC_VOLUMES_MAPPING_ID2":   MappingSdcID,
+		"POWERFLEX_SDC_VOLUMES_MAPPING_NAME2": "terraform_sdc",
+		"POWERFLEX_SDC_APPROVAL_GUID":         "6F1D1A70-3A1E-4D26-9E24-0B2D8C1F0010",
+
+		"POWERFLEX_PRIMARY_MDM_IP":   "192.0.2.1",
+		"POWERFLEX_SECONDARY_MDM_IP": "192.0.2.2",
+		"POWERFLEX_TB_IP":            "192.0.2.3",
+		"POWERFLEX_STANDBY_MDM_IP":   "192.0.2.20",
+		"POWERFLEX_STANDBY_TB_IP":    "192.0.2.21",
+
+		"POWERFLEX_REPLICATION_REMOTE_SYSTEM_ID":            RemoteSystemID,
+		"POWERFLEX_REPLICATION_REMOTE_MDM_IP":               "192.0.2.200",
+		"POWERFLEX_REPLICATION_REMOTE_PROTECTION_DOMAIN_ID": RemoteProtectionDomainID,
+		"POWERFLEX_REPLICATION_REMOTE_VOLUME_ID":            RemoteVolumeID,
+	}
+	// free IPs for the SDSs created by the tests
+	for i := 1; i <= 11; i++ {
+		env["POWERFLEX_SDS_IP_"+strconv.Itoa(i)] = "192.0.2." + strconv.Itoa(100+i)
+	}
+	return env
+}
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+const packagesPath = "/im/types/installationPackages/instances"
+
+// packageName matches the names of the installation packages, for instance
+// EMC-ScaleIO-lia-3.6-700.103.Ubuntu.22.04.x86_64.tar or EMC-ScaleIO-sdc-3.6-700.103.el7.x86_64.rpm.
+var packageName = regexp.MustCompile(`^EMC-ScaleIO-([a-z0-9]+)-(\d+\.\d+)-(\d+)\.(\d+)\.([A-Za-z]+[0-9]*)`)
+
+// serveGateway serves the installation packages of the installer API, the credentials
+// being sent with every request.
+func (s *Simulator) serveGateway(w http.ResponseWriter, r *http.Request) {
+	if username, password, ok := r.BasicAuth(); !ok || username != s.Username || password != s.Password {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == packagesPath:
+		packages := s.packages
+		if packages == nil {
+			packages = []*scaleiotypes.PackageDetails{}
+		}
+		writeJSON(w, packages)
+	case r.Method == http.MethodPost && r.URL.Path == packagesPath+"/actions/uploadPackages":
+		s.uploadPackages(w, r)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, packagesPath+"/actions/delete::"):
+		s.deletePackage(w, strings.TrimPrefix(r.URL.Path, packagesPath+"/actions/delete::"))
+	default:
+		writeError(w, http.StatusNotFound, "Unsupported request "+r.Method+" "+r.URL.Path)
+	}
+}
+
+// uploadPackages adds the packages sent as the files of a multipart form.
+func (s *Simulator) uploadPackages(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	files := r.MultipartForm.File["files"]
+	if len(files) == 0 {
+		writeError(w, http.StatusBadRequest, "No package to upload")
+		return
+	}
+
+	uploaded := make([]*scaleiotypes.PackageDetails, 0, len(files))
+	for _, file := range files {
+		details, err := packageDetails(file.Filename, int(file.Size))
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		uploaded = append(uploaded, details)
+	}
+	for _, details := range uploaded {
+		s.removePackage(details.Filename)
+		s.packages = append(s.packages, details)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// deletePackage removes an installation package.
+func (s *Simulator) deletePackage(w http.ResponseWriter, name string) {
+	if !s.removePackage(name) {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("The package %s does not exist", name))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// removePackage removes the package with the given file name and reports whether it existed.
+func (s *Simulator) removePackage(name string) bool {
+	for i, details := range s.packages {
+		if details.Filename == name {
+			s.packages = append(s.packages[:i], s.packages[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// packageDetails returns the details of a package, read from its file name like the gateway does.
+func packageDetails(filename string, size int) (*scaleiotypes.PackageDetails, error) {
+	match := packageName.FindStringSubmatch(filename)
+	if match == nil {
+		return nil, fmt.Errorf("The file %s is not a PowerFlex installation package", filename)
+	}
+	patch, _ := strconv.Atoi(match[3])
+	return &scaleiotypes.PackageDetails{
+		Filename:        filename,
+		OperatingSystem: "linux",
+		LinuxFlavour:    match[5],
+		Version:         match[2],
+		SioPatchNumber:  patch,
+		Label:           match[3] + "." + match[4],
+		Type:            match[1],
+		Size:            size,
+		Latest:          true,
+	}, nil
+}
@@ -0,0 +1,726 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	kbPerGB = 1024 * 1024
+	// volumeGranularityInGB is the granularity of the volume sizes, PowerFlex rounds the sizes up to it.
+	volumeGranularityInGB = 8
+	// maxVolumeSizeInGB is the largest volume the storage pools of the simulator can allocate.
+	maxVolumeSizeInGB = 1024
+	// deviceCapacityInGB is the capacity of the devices added to the simulator.
+	deviceCapacityInGB = 512
+	// maxRetainedSnapshots is the largest number of snapshots a snapshot policy can retain over all its levels.
+	maxRetainedSnapshots = 60
+	// maxRetentionLevels is the largest number of retention levels of a snapshot policy.
+	maxRetentionLevels = 6
+	// defaultPeerMdmPort is the port a peer MDM is reached on when none is given.
+	defaultPeerMdmPort = 7611
+	// minRpoInSeconds and maxRpoInSeconds bound the RPO of a replication consistency group.
+	minRpoInSeconds = 15
+	maxRpoInSeconds = 3600
+	// defaultMdmPort is the port an MDM listens on when none is given.
+	defaultMdmPort = 9011
+)
+
+// creators build the objects created with a POST on the instances of their type.
+var creators = map[string]func(s *Simulator, p params) (object, error){
+	"ProtectionDomain":            (*Simulator).newProtectionDomain,
+	"StoragePool":                 (*Simulator).newStoragePool,
+	"AccelerationPool":            (*Simulator).newAccelerationPool,
+	"FaultSet":                    (*Simulator).newFaultSet,
+	"Sds":                         (*Simulator).newSds,
+	"Device":                      (*Simulator).newDevice,
+	"Volume":                      (*Simulator).newVolume,
+	"SnapshotPolicy":              (*Simulator).newSnapshotPolicy,
+	"PeerMdm":                     (*Simulator).newPeerMdm,
+	"ReplicationConsistencyGroup": (*Simulator).newReplicationConsistencyGroup,
+	"ReplicationPair":             (*Simulator).newReplicationPair,
+	"User":                        (*Simulator).newUser,
+}
+
+// systemID returns the ID of the system the objects are created in.
+func (s *Simulator) systemID() string {
+	for id := range s.objects["System"] {
+		return id
+	}
+	return ""
+}
+
+// checkNewName returns an error when the name is invalid, or already used by another object
+// of the same type matching the scope.
+func (s *Simulator) checkNewName(objectType, name string, scope func(object) bool) error {
+	if err := checkName(name); err != nil {
+		return err
+	}
+	if name == "" {
+		return nil
+	}
+	for _, obj := range s.objects[objectType] {
+		if obj["name"] == name && (scope == nil || scope(obj)) {
+			return fmt.Errorf("%s name already in use", strings.ToUpper(displayName(objectType)[:1])+displayName(objectType)[1:])
+		}
+	}
+	return nil
+}
+
+// sameField returns a scope matching the objects with the given value in a field.
+func sameField(field string, value interface{}) func(object) bool {
+	return func(obj object) bool { return obj[field] == value }
+}
+
+func (s *Simulator) newProtectionDomain(p params) (object, error) {
+	if err := s.checkNewName("ProtectionDomain", p.str("name"), nil); err != nil {
+		return nil, err
+	}
+	return object{
+		"name":                          p.str("name"),
+		"systemId":                      s.systemID(),
+		"protectionDomainState":         "Active",
+		"rfcacheEnabled":                true,
+		"rfcacheOpertionalMode":         "WriteMiss",
+		"rfcachePageSizeKb":             64,
+		"rfcacheMaxIoSizeKb":            128,
+		"fglDefaultNumConcurrentWrites": 1000,
+		"fglMetadataCacheEnabled":       false,
+		"fglDefaultMetadataCacheSize":   0,
+	}, nil
+}
+
+func (s *Simulator) newStoragePool(p params) (object, error) {
+	pdID := p.str("protectionDomainId")
+	if _, err := s.get("ProtectionDomain", pdID); err != nil {
+		return nil, err
+	}
+	if err := s.checkNewName("StoragePool", p.str("name"), sameField("protectionDomainId", pdID)); err != nil {
+		return nil, err
+	}
+	sparePercentage := 10
+	if p.has("sparePercentage") {
+		var err error
+		if sparePercentage, err = p.integer("sparePercentage"); err != nil {
+			return nil, err
+		}
+	}
+	mediaType := p.str("mediaType")
+	if mediaType == "" {
+		mediaType = "HDD"
+	}
+	writeHandlingMode := p.str("rmcacheWriteHandlingMode")
+	if writeHandlingMode == "" {
+		writeHandlingMode = "Cached"
+	}
+	return object{
+		"name":                           p.str("name"),
+		"protectionDomainId":             pdID,
+		"mediaType":                      mediaType,
+		"dataLayout":                     "MediumGranularity",
+		"sparePercentage":                sparePercentage,
+		"rebuildEnabled":                 !p.has("rebuildEnabled") || p.boolean("rebuildEnabled"),
+		"rebalanceEnabled":               !p.has("rebalanceEnabled") || p.boolean("rebalanceEnabled"),
+		"zeroPaddingEnabled":             p.boolean("zeroPaddingEnabled"),
+		"useRmcache":                     p.boolean("useRmcache"),
+		"useRfcache":                     p.boolean("useRfcache"),
+		"rmcacheWriteHandlingMode":       writeHandlingMode,
+		"capacityAlertHighThreshold":     80,
+		"capacityAlertCriticalThreshold": 90,
+		"numOfParallelRebuildRebalanceJobsPerDevice": 2,
+		"rebalanceIoPriorityPolicy":                  "favorAppIos",
+		"rebuildIoPriorityPolicy":                    "limitNumOfConcurrentIos",
+		"vtreeMigrationIoPriorityPolicy":             "favorAppIos",
+		"protectedMaintenanceModeIoPriorityPolicy":   "limitNumOfConcurrentIos",
+		"fragmentationEnabled":                       true,
+		"replicationCapacityMaxRatio":                0,
+	}, nil
+}
+
+func (s *Simulator) newAccelerationPool(p params) (object, error) {
+	pdID := p.str("protectionDomainId")
+	if _, err := s.get("ProtectionDomain", pdID); err != nil {
+		return nil, err
+	}
+	if err := s.checkNewName("AccelerationPool", p.str("name"), sameField("protectionDomainId", pdID)); err != nil {
+		return nil, err
+	}
+	mediaType := p.str("mediaType")
+	if mediaType != "NVDIMM" && mediaType != "SSD" {
+		return nil, fmt.Errorf("Invalid acceleration pool media type %s", mediaType)
+	}
+	return object{
+		"name":               p.str("name"),
+		"protectionDomainId": pdID,
+		"mediaType":          mediaType,
+	}, nil
+}
+
+func (s *Simulator) newFaultSet(p params) (object, error) {
+	pdID := p.str("protectionDomainId")
+	if _, err := s.get("ProtectionDomain", pdID); err != nil {
+		return nil, err
+	}
+	if err := s.checkNewName("FaultSet", p.str("name"), sameField("protectionDomainId", pdID)); err != nil {
+		return nil, err
+	}
+	return object{
+		"name":               p.str("name"),
+		"protectionDomainId": pdID,
+	}, nil
+}
+
+func (s *Simulator) newSds(p params) (object, error) {
+	pdID := p.str("protectionDomainId")
+	if _, err := s.get("ProtectionDomain", pdID); err != nil {
+		return nil, err
+	}
+	if faultSetID := p.str("faultSetId"); faultSetID != "" {
+		faultSet, err := s.get("FaultSet", faultSetID)
+		if err != nil {
+			return nil, err
+		}
+		if faultSet["protectionDomainId"] != pdID {
+			return nil, errors.New("The fault set and the SDS belong to different protection domains")
+		}
+	}
+	if err := s.checkNewName("Sds", p.str("name"), nil); err != nil {
+		return nil, err
+	}
+	port := 7072
+	if p.has("sdsPort") {
+		var err error
+		if port, err = p.integer("sdsPort"); err != nil {
+			return nil, err
+		}
+	}
+	ipList := []object{}
+	for _, entry := range p.list("sdsIpList") {
+		ip := params(entry)
+		if sdsIP, ok := entry["SdsIp"].(map[string]interface{}); ok {
+			ip = params(sdsIP)
+		}
+		if s.sdsWithIP(ip.str("ip"), port) != nil {
+			return nil, errors.New("The SDS IP address and port already in use")
+		}
+		ipList = append(ipList, object{"ip": ip.str("ip"), "role": ip.str("role")})
+	}
+	if len(ipList) == 0 {
+		return nil, errors.New("At least one IP address is required to add an SDS")
+	}
+	rmcacheSizeInKb, err := p.integer("rmcacheSizeInKb")
+	if err != nil {
+		return nil, err
+	}
+	if rmcacheSizeInKb == 0 {
+		rmcacheSizeInKb = 128 * 1024
+	}
+	drlMode := p.str("drlMode")
+	if drlMode == "" {
+		drlMode = "Volatile"
+	}
+	return object{
+		"name":               p.str("name"),
+		"protectionDomainId": pdID,
+		"ipList":             ipList,
+		"port":               port,
+		"sdsState":           "Normal",
+		"membershipState":    "Joined",
+		"mdmConnectionState": "Connected",
+		"drlMode":            drlMode,
+		"rmcacheEnabled":     !p.has("rmcacheEnabled") || p.boolean("rmcacheEnabled"),
+		"rmcacheSizeInKb":    rmcacheSizeInKb,
+		"rfcacheEnabled":     true,
+		"perfProfile":        "HighPerformance",
+		"faultSetId":         p.str("faultSetId"),
+	}, nil
+}
+
+// sdsWithIP returns the SDS listening on the given IP and port.
+func (s *Simulator) sdsWithIP(ip string, port int) object {
+	for _, sds := range s.objects["Sds"] {
+		for _, sdsIP := range sds["ipList"].([]object) {
+			if sdsIP["ip"] == ip && sds["port"] == port {
+				return sds
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Simulator) newDevice(p params) (object, error) {
+	// a device is either a storage device of a storage pool, or an acceleration device of an acceleration pool
+	poolType, poolField, poolTitle := "StoragePool", "storagePoolId", "Storage Pool"
+	if p.str("accelerationPoolId") != "" {
+		if p.str("storagePoolId") != "" {
+			return nil, errors.New("Only one of storage pool and acceleration pool can be set")
+		}
+		poolType, poolField, poolTitle = "AccelerationPool", "accelerationPoolId", "Acceleration Pool"
+	}
+	pool, err := s.get(poolType, p.str(poolField))
+	if err != nil {
+		return nil, err
+	}
+	sds, err := s.get("Sds", p.str("sdsId"))
+	if err != nil {
+		return nil, err
+	}
+	if pool["protectionDomainId"] != sds["protectionDomainId"] {
+		return nil, fmt.Errorf("The SDS and the %s belong to different protection domains", displayName(poolType))
+	}
+	if err := s.checkNewName("Device", p.str("name"), sameField("sdsId", sds["id"])); err != nil {
+		return nil, err
+	}
+	path := p.str("deviceCurrentPathname")
+	for _, device := range s.objects["Device"] {
+		if device["sdsId"] == sds["id"] && device["deviceCurrentPathName"] == path {
+			return nil, fmt.Errorf("The device %s is already in use by the SDS", path)
+		}
+	}
+	mediaType := p.str("mediaType")
+	if mediaType == "" {
+		mediaType = pool["mediaType"].(string)
+	}
+	if mediaType != pool["mediaType"] {
+		return nil, fmt.Errorf("The device media type is not compatible with the %s media type", poolTitle)
+	}
+	capacityLimitInKb, err := p.integer("capacityLimitInKb")
+	if err != nil {
+		return nil, err
+	}
+	if capacityLimitInKb == 0 {
+		capacityLimitInKb = deviceCapacityInGB * kbPerGB
+	}
+	externalAccelerationType := p.str("externalAccelerationType")
+	if externalAccelerationType == "" {
+		externalAccelerationType = "None"
+	}
+	return object{
+		"name":                     p.str("name"),
+		poolField:                  pool["id"],
+		"sdsId":                    sds["id"],
+		"deviceCurrentPathName":    path,
+		"deviceOriginalPathName":   path,
+		"deviceState":              "Normal",
+		"errorState":               "None",
+		"mediaType":                mediaType,
+		"externalAccelerationType": externalAccelerationType,
+		"capacityLimitInKb":        capacityLimitInKb,
+		"maxCapacityInKb":          deviceCapacityInGB * kbPerGB,
+		"capacity":                 deviceCapacityInGB,
+	}, nil
+}
+
+func (s *Simulator) newVolume(p params) (object, error) {
+	pool, err := s.get("StoragePool", p.str("storagePoolId"))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNewName("Volume", p.str("name"), nil); err != nil {
+		return nil, err
+	}
+	sizeInKb, err := p.integer("volumeSizeInKb")
+	if err != nil {
+		return nil, err
+	}
+	sizeInKb, err = volumeSize(sizeInKb)
+	if err != nil {
+		return nil, err
+	}
+	volumeType := p.str("volumeType")
+	if volumeType == "" {
+		volumeType = "ThinProvisioned"
+	}
+	compressionMethod, err := s.compressionMethod(pool, p.str("compressionMethod"))
+	if err != nil {
+		return nil, err
+	}
+	s.lastID++
+	return object{
+		"name":                 p.str("name"),
+		"storagePoolId":        pool["id"],
+		"sizeInKb":             sizeInKb,
+		"volumeType":           volumeType,
+		"vtreeId":              fmt.Sprintf("5a5b%012x", s.lastID),
+		"ancestorVolumeId":     "",
+		"snplIdOfSourceVolume": "",
+		"mappedSdcInfo":        []object{},
+		"useRmcache":           p.boolean("useRmcache"),
+		"accessModeLimit":      "ReadWrite",
+		"compressionMethod":    compressionMethod,
+		"dataLayout":           pool["dataLayout"],
+		"creationTime":         int(time.Now().Unix()),
+	}, nil
+}
+
+// volumeSize returns the size a volume is allocated with, rounded up to the granularity of PowerFlex.
+func volumeSize(sizeInKb int) (int, error) {
+	if sizeInKb <= 0 {
+		return 0, errors.New("The volume size must be a positive number")
+	}
+	granularity := volumeGranularityInGB * kbPerGB
+	sizeInKb = (sizeInKb + granularity - 1) / granularity * granularity
+	if sizeInKb > maxVolumeSizeInGB*kbPerGB {
+		return 0, errors.New("Requested volume size exceeds the volume allocation limit")
+	}
+	return sizeInKb, nil
+}
+
+// compressionMethod checks that the storage pool of a volume supports the compression method.
+func (s *Simulator) compressionMethod(pool object, method string) (string, error) {
+	switch method {
+	case "", "None":
+		return "None", nil
+	case "Normal":
+		if pool["dataLayout"] != "FineGranularity" {
+			return "", errors.New("Compression is only supported on fine granularity storage pools")
+		}
+		return method, nil
+	}
+	return "", fmt.Errorf("Invalid compression method %s", method)
+}
+
+func (s *Simulator) newSnapshotPolicy(p params) (object, error) {
+	if err := s.checkNewName("SnapshotPolicy", p.str("name"), nil); err != nil {
+		return nil, err
+	}
+	cadence, retained, err := snapshotSchedule(p)
+	if err != nil {
+		return nil, err
+	}
+	accessMode := p.str("snapshotAccessMode")
+	switch accessMode {
+	case "":
+		accessMode = "ReadOnly"
+	case "ReadOnly", "ReadWrite":
+	default:
+		return nil, fmt.Errorf("Invalid snapshot access mode %s", accessMode)
+	}
+	state := "Active"
+	if p.boolean("paused") {
+		state = "Paused"
+	}
+	return object{
+		"name":                             p.str("name"),
+		"systemId":                         s.systemID(),
+		"snapshotPolicyState":              state,
+		"autoSnapshotCreationCadenceInMin": cadence,
+		"numOfRetainedSnapshotsPerLevel":   retained,
+		"maxVTreeAutoSnapshots":            sum(retained),
+		"snapshotAccessMode":               accessMode,
+		"secureSnapshots":                  p.boolean("secureSnapshots"),
+		"numOfSourceVolumes":               0,
+	}, nil
+}
+
+func (s *Simulator) newPeerMdm(p params) (object, error) {
+	if err := s.checkNewName("PeerMdm", p.str("name"), nil); err != nil {
+		return nil, err
+	}
+	peerSystemID := p.str("peerSystemId")
+	if peerSystemID == "" || peerSystemID == s.systemID() {
+		return nil, fmt.Errorf("Invalid peer system ID %q", peerSystemID)
+	}
+	if s.find("PeerMdm", "peerSystemId", peerSystemID) != nil {
+		return nil, errors.New("The peer system is already registered")
+	}
+	ipList, err := peerMdmIPList(p.strs("peerSystemIps"))
+	if err != nil {
+		return nil, err
+	}
+	port := defaultPeerMdmPort
+	if p.has("port") {
+		if port, err = p.integer("port"); err != nil {
+			return nil, err
+		}
+	}
+	perfProfile := p.str("perfProfile")
+	if perfProfile == "" {
+		perfProfile = "HighPerformance"
+	}
+	return object{
+		"name":                p.str("name"),
+		"peerSystemId":        peerSystemID,
+		"systemId":            s.systemID(),
+		"port":                port,
+		"ipList":              ipList,
+		"perfProfile":         perfProfile,
+		"softwareVersionInfo": "R3_6.0.0",
+		"membershipState":     "Joined",
+		"networkType":         "External",
+		"couplingRC":          "SUCCESS",
+	}, nil
+}
+
+// newMdm builds a member of the MDM cluster, standby MDMs are added with addStandbyMdm
+// and take a role in the cluster with switchClusterMode.
+func (s *Simulator) newMdm(p params, clusterRole string) (object, error) {
+	if err := s.checkNewName("Mdm", p.str("name"), nil); err != nil {
+		return nil, err
+	}
+	ips := p.strs("ips")
+	if len(ips) == 0 {
+		return nil, errors.New("At least one IP address is required to add an MDM")
+	}
+	for _, ip := range ips {
+		for _, mdm := range s.objects["Mdm"] {
+			for _, used := range mdm["ips"].([]string) {
+				if used == ip {
+					return nil, fmt.Errorf("The IP %s is already used by an MDM", ip)
+				}
+			}
+		}
+	}
+	role := p.str("role")
+	if role != "Manager" && role != "TieBreaker" {
+		return nil, fmt.Errorf("Invalid MDM role %q", role)
+	}
+	port := defaultMdmPort
+	if p.has("port") {
+		var err error
+		if port, err = p.integer("port"); err != nil {
+			return nil, err
+		}
+	}
+	managementIPs := p.strs("managementIps")
+	if role == "TieBreaker" {
+		managementIPs = []string{}
+	}
+	return object{
+		"name":          p.str("name"),
+		"ips":           ips,
+		"managementIPs": managementIPs,
+		"port":          port,
+		"role":          role,
+		"clusterRole":   clusterRole,
+		"status":        "Normal",
+		"versionInfo":   "R3_6.0.0",
+	}, nil
+}
+
+// peerMdmIPList returns the IP list of a peer MDM.
+func peerMdmIPList(ips []string) ([]object, error) {
+	if len(ips) == 0 {
+		return nil, errors.New("At least one IP address is required to reach the peer system")
+	}
+	ipList := []object{}
+	for _, ip := range ips {
+		ipList = append(ipList, object{"ip": ip})
+	}
+	return ipList, nil
+}
+
+func (s *Simulator) newReplicationConsistencyGroup(p params) (object, error) {
+	if err := s.checkNewName("ReplicationConsistencyGroup", p.str("name"), nil); err != nil {
+		return nil, err
+	}
+	rpo, err := rpoInSeconds(p)
+	if err != nil {
+		return nil, err
+	}
+	pdID := p.str("protectionDomainId")
+	if _, err := s.get("ProtectionDomain", pdID); err != nil {
+		return nil, err
+	}
+	if p.str("remoteProtectionDomainId") == "" {
+		return nil, errors.New("The remote protection domain ID is required")
+	}
+	peerMdm := s.find("PeerMdm", "peerSystemId", p.str("destinationSystemId"))
+	if peerMdmID := p.str("peerMdmId"); peerMdmID != "" {
+		if peerMdm, err = s.get("PeerMdm", peerMdmID); err != nil {
+			return nil, err
+		}
+	}
+	if peerMdm == nil {
+		return nil, fmt.Errorf("Could not find the %s", displayName("PeerMdm"))
+	}
+	s.lastID++
+	return object{
+		"name":                     p.str("name"),
+		"rpoInSeconds":             rpo,
+		"protectionDomainId":       pdID,
+		"remoteProtectionDomainId": p.str("remoteProtectionDomainId"),
+		"peerMdmId":                peerMdm["id"],
+		"destinationSystemId":      peerMdm["peerSystemId"],
+		"remoteId":                 fmt.Sprintf("a5a5%012x", s.lastID),
+		"replicationDirection":     "LocalToRemote",
+		"currConsistMode":          "Consistent",
+		"freezeState":              "Unfrozen",
+		"failoverType":             "None",
+		"failoverState":            "None",
+		"disasterRecoveryState":    "Neutral",
+		"pauseMode":                "None",
+		"lifetimeState":            "Normal",
+		"type":                     "User",
+		"targetVolumeAccessMode":   "NoAccess",
+		"abstractState":            "Ok",
+		"localActivityState":       "Active",
+		"remoteActivityState":      "Active",
+	}, nil
+}
+
+func (s *Simulator) newReplicationPair(p params) (object, error) {
+	rcg, err := s.get("ReplicationConsistencyGroup", p.str("replicationConsistencyGroupId"))
+	if err != nil {
+		return nil, err
+	}
+	volume, err := s.get("Volume", p.str("sourceVolumeId"))
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.get("StoragePool", volume["storagePoolId"].(string))
+	if err != nil {
+		return nil, err
+	}
+	if pool["protectionDomainId"] != rcg["protectionDomainId"] {
+		return nil, errors.New("The source volume and the replication consistency group belong to different protection domains")
+	}
+	if s.find("ReplicationPair", "localVolumeId", volume["id"]) != nil {
+		return nil, errors.New("The source volume is already replicated")
+	}
+	if p.str("destinationVolumeId") == "" {
+		return nil, errors.New("The destination volume ID is required")
+	}
+	copyType := p.str("copyType")
+	switch copyType {
+	case "":
+		copyType = "OnlineCopy"
+	case "OnlineCopy", "OnlineHashCopy", "OfflineCopy":
+	default:
+		return nil, fmt.Errorf("Invalid copy type %s", copyType)
+	}
+	peerSystemName := ""
+	if peerMdm, err := s.get("PeerMdm", rcg["peerMdmId"].(string)); err == nil {
+		peerSystemName = peerMdm["name"].(string)
+	}
+	// the simulator does not know the remote volumes, the target is named after the source
+	s.lastID++
+	return object{
+		"name":                          p.str("name"),
+		"localVolumeId":                 volume["id"],
+		"remoteVolumeId":                p.str("destinationVolumeId"),
+		"remoteVolumeName":              volume["name"],
+		"remoteId":                      fmt.Sprintf("a5a5%012x", s.lastID),
+		"remoteCapacityInMB":            volume["sizeInKb"].(int) / 1024,
+		"replicationConsistencyGroupId": rcg["id"],
+		"copyType":                      copyType,
+		"lifetimeState":                 "Normal",
+		"peerSystemName":                peerSystemName,
+		"initialCopyState":              "Done",
+		"initialCopyPriority":           1,
+		"initialCopyProgress":           1.0,
+	}, nil
+}
+
+// rpoInSeconds returns the RPO of a replication consistency group.
+func rpoInSeconds(p params) (int, error) {
+	rpo, err := p.integer("rpoInSeconds")
+	if err != nil {
+		return 0, err
+	}
+	if rpo < minRpoInSeconds || rpo > maxRpoInSeconds {
+		return 0, fmt.Errorf("The RPO must be between %d and %d seconds", minRpoInSeconds, maxRpoInSeconds)
+	}
+	return rpo, nil
+}
+
+// snapshotSchedule returns the cadence and the retained snapshots per level of a snapshot policy.
+func snapshotSchedule(p params) (int, []int, error) {
+	cadence, err := p.integer("autoSnapshotCreationCadenceInMin")
+	if err != nil {
+		return 0, nil, err
+	}
+	if cadence <= 0 {
+		return 0, nil, errors.New("The auto snapshot creation cadence must be a positive number of minutes")
+	}
+	retained, err := p.ints("numOfRetainedSnapshotsPerLevel")
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(retained) == 0 || len(retained) > maxRetentionLevels {
+		return 0, nil, fmt.Errorf("A snapshot policy must have between 1 and %d retention levels", maxRetentionLevels)
+	}
+	for _, count := range retained {
+		if count <= 0 {
+			return 0, nil, errors.New("The number of retained snapshots of a level must be a positive number")
+		}
+	}
+	if sum(retained) > maxRetainedSnapshots {
+		return 0, nil, fmt.Errorf("The total number of retained snapshots exceeds the maximum of %d", maxRetainedSnapshots)
+	}
+	return cadence, retained, nil
+}
+
+func sum(values []int) int {
+	total := 0
+	for _, value := range values {
+		total += value
+	}
+	return total
+}
+
+// mappingOf returns the mapping of a volume to an SDC.
+func mappingOf(volume object, sdcID string) object {
+	for _, mapping := range volume["mappedSdcInfo"].([]object) {
+		if mapping["sdcId"] == sdcID {
+			return mapping
+		}
+	}
+	return nil
+}
+
+// userRoles lists the roles PowerFlex accepts for a user.
+var userRoles = []string{"Monitor", "Configure", "Administrator", "Security", "FrontendConfig", "BackendConfig", "SuperUser"}
+
+// checkUserRole returns the error PowerFlex returns for an invalid user role.
+func checkUserRole(role string) error {
+	for _, valid := range userRoles {
+		if role == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid user role %q", role)
+}
+
+// newUser builds a user, who has to change the password at the first login.
+// The simulator does not keep the passwords of the users, only the credentials of the simulator log in.
+func (s *Simulator) newUser(p params) (object, error) {
+	name := p.str("name")
+	if name == "" {
+		return nil, errors.New("The user name is required")
+	}
+	if err := s.checkNewName("User", name, nil); err != nil {
+		return nil, err
+	}
+	if err := checkUserRole(p.str("userRole")); err != nil {
+		return nil, err
+	}
+	if p.str("password") == "" {
+		return nil, errors.New("The password is required")
+	}
+	return object{
+		"name":                   name,
+		"userRole":               p.str("userRole"),
+		"systemId":               s.systemID(),
+		"passwordChangeRequired": true,
+	}, nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxNameLength is the longest name PowerFlex accepts for an object.
+const maxNameLength = 31
+
+// validName matches the characters PowerFlex accepts in the name of an object.
+var validName = regexp.MustCompile(`^[A-Za-z0-9_.:\-]*$`)
+
+// params are the parameters of a request. PowerFlex takes most of them as strings,
+// including the numbers and the booleans.
+type params map[string]interface{}
+
+// has reports whether the parameter is set.
+func (p params) has(key string) bool {
+	value, ok := p[key]
+	return ok && value != nil
+}
+
+// str returns a parameter as a string.
+func (p params) str(key string) string {
+	if !p.has(key) {
+		return ""
+	}
+	if value, ok := p[key].(string); ok {
+		return value
+	}
+	return fmt.Sprint(p[key])
+}
+
+// integer returns a parameter as an integer, zero when it is not set.
+func (p params) integer(key string) (int, error) {
+	value := p.str(key)
+	if value == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid value %q for parameter %s", value, key)
+	}
+	return i, nil
+}
+
+// boolean returns a parameter as a boolean, false when it is not set.
+func (p params) boolean(key string) bool {
+	return strings.EqualFold(p.str(key), "true")
+}
+
+// list returns a parameter holding a list of objects.
+func (p params) list(key string) []params {
+	values, _ := p[key].([]interface{})
+	list := make([]params, 0, len(values))
+	for _, value := range values {
+		if m, ok := value.(map[string]interface{}); ok {
+			list = append(list, params(m))
+		}
+	}
+	return list
+}
+
+// ints returns a parameter holding a list of integers.
+func (p params) ints(key string) ([]int, error) {
+	values, _ := p[key].([]interface{})
+	ints := make([]int, 0, len(values))
+	for _, value := range values {
+		i, err := params{key: value}.integer(key)
+		if err != nil {
+			return nil, err
+		}
+		ints = append(ints, i)
+	}
+	return ints, nil
+}
+
+// strs returns a parameter holding a list of strings.
+func (p params) strs(key string) []string {
+	values, _ := p[key].([]interface{})
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, params{key: value}.str(key))
+	}
+	return strs
+}
+
+// checkName returns the error PowerFlex returns for an invalid object name.
+func checkName(name string) error {
+	if len(name) > maxNameLength {
+		return fmt.Errorf("The given name exceeds the allowed length of %d characters", maxNameLength)
+	}
+	if !validName.MatchString(name) {
+		return fmt.Errorf("The given name %q contains invalid characters", name)
+	}
+	return nil
+}
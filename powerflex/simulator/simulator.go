@@ -0,0 +1,481 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulator implements an in-memory fake of the PowerFlex REST API and of the
+// installer API of the gateway, so that the acceptance tests can run without a PowerFlex system.
+package simulator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+const (
+	// DefaultUsername is the user name accepted by a new simulator.
+	DefaultUsername = "admin"
+	// DefaultPassword is the password accepted by a new simulator.
+	DefaultPassword = "Password123!"
+	// apiVersion is the PowerFlex version reported by the simulator.
+	apiVersion = "3.6"
+)
+
+// object is a PowerFlex object, stored with the field names of the REST API.
+type object map[string]interface{}
+
+// Simulator is a fake PowerFlex system served over HTTPS.
+type Simulator struct {
+	// Username and Password are the credentials of the REST API and of the gateway.
+	Username string
+	Password string
+
+	server   *httptest.Server
+	mu       sync.Mutex
+	objects  map[string]map[string]object
+	packages []*scaleiotypes.PackageDetails
+	tokens   map[string]bool
+	lastID   uint64
+	// migrations holds the migration info of the VTrees, by VTree ID
+	migrations map[string]object
+}
+
+// New returns a simulator holding the fixtures the acceptance tests rely on.
+func New() *Simulator {
+	s := &Simulator{
+		Username:   DefaultUsername,
+		Password:   DefaultPassword,
+		objects:    map[string]map[string]object{},
+		tokens:     map[string]bool{},
+		migrations: map[string]object{},
+	}
+	s.seed()
+	return s
+}
+
+// Start serves the simulator on a local HTTPS endpoint and returns its URL.
+func (s *Simulator) Start() string {
+	s.server = httptest.NewTLSServer(s)
+	return s.server.URL
+}
+
+// Close stops serving the simulator.
+func (s *Simulator) Close() {
+	if s.server != nil {
+		s.server.Close()
+	}
+}
+
+// Delete removes an object, as if it was deleted outside Terraform.
+func (s *Simulator) Delete(objectType, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects[objectType], id)
+}
+
+// Exists reports whether the simulator holds the given object.
+func (s *Simulator) Exists(objectType, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.objects[objectType][id] != nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Simulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/im/"):
+		s.serveGateway(w, r)
+	case r.URL.Path == "/api/login":
+		s.login(w, r)
+	case !s.authorized(r):
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+	case r.URL.Path == "/api/version":
+		writeJSON(w, apiVersion)
+	case strings.HasPrefix(r.URL.Path, "/api/"):
+		s.serveAPI(w, r)
+	default:
+		writeError(w, http.StatusNotFound, "Unsupported request "+r.Method+" "+r.URL.Path)
+	}
+}
+
+// login creates a session for the credentials sent with a basic authentication.
+func (s *Simulator) login(w http.ResponseWriter, r *http.Request) {
+	if username, password, ok := r.BasicAuth(); !ok || username != s.Username || password != s.Password {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	s.lastID++
+	token := fmt.Sprintf("simulator-token-%d", s.lastID)
+	s.tokens[token] = true
+	writeJSON(w, token)
+}
+
+// authorized reports whether the request carries the token of a session,
+// as the password of a basic authentication or as a bearer token.
+func (s *Simulator) authorized(r *http.Request) bool {
+	if token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "); s.tokens[token] {
+		return true
+	}
+	_, token, ok := r.BasicAuth()
+	return ok && s.tokens[token]
+}
+
+// serveAPI dispatches the requests on the objects of the system.
+func (s *Simulator) serveAPI(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/"), "/"), "/")
+	body, err := readParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var resp interface{}
+	switch {
+	case len(parts) == 3 && parts[0] == "types" && parts[2] == "instances" && r.Method == http.MethodGet:
+		resp = s.list(parts[1], nil)
+	case len(parts) == 3 && parts[0] == "types" && parts[2] == "instances" && r.Method == http.MethodPost:
+		resp, err = s.create(parts[1], body)
+	case len(parts) == 5 && parts[0] == "types" && parts[3] == "action" && parts[4] == "queryIdByKey":
+		resp, err = s.queryIDByKey(parts[1], body)
+	case len(parts) >= 2 && parts[0] == "instances":
+		resp, err = s.serveInstance(r.Method, parts[1], parts[2:], body)
+	default:
+		writeError(w, http.StatusNotFound, "Unsupported request "+r.Method+" "+r.URL.Path)
+		return
+	}
+
+	var reqErr *requestError
+	switch {
+	case errors.As(err, &reqErr):
+		writeError(w, reqErr.status, reqErr.Error())
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err.Error())
+	default:
+		writeJSON(w, resp)
+	}
+}
+
+// serveInstance serves the requests on a single object: reading it, its related objects, or running an action.
+func (s *Simulator) serveInstance(method, instance string, path []string, body params) (interface{}, error) {
+	objectType, id, _ := strings.Cut(instance, "::")
+	// the actions on the MDM cluster are run on the system without giving its ID
+	if objectType == "System" && id == "" {
+		id = s.systemID()
+	}
+	// the VTrees are not stored, they are built from the volumes they hold
+	if objectType == "VTree" && len(path) == 0 && method == http.MethodGet {
+		return s.vtree(id)
+	}
+	obj, err := s.get(objectType, id)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(path) == 0 && method == http.MethodGet:
+		return s.render(objectType, obj), nil
+	case len(path) == 2 && path[0] == "relationships" && path[1] == "Statistics" && method == http.MethodGet:
+		return s.statistics(objectType, obj), nil
+	case len(path) == 2 && path[0] == "relationships" && method == http.MethodGet:
+		return s.related(objectType, id, path[1]), nil
+	case len(path) == 2 && path[0] == "action" && method == http.MethodPost:
+		run, ok := actions[objectType][path[1]]
+		if !ok {
+			return nil, &requestError{http.StatusBadRequest, fmt.Sprintf("Unsupported action %s on %s", path[1], objectType)}
+		}
+		resp, err := run(s, id, obj, body)
+		if resp == nil {
+			resp = struct{}{}
+		}
+		return resp, err
+	}
+	return nil, &requestError{http.StatusNotFound, "Unsupported request " + method + " " + instance + "/" + strings.Join(path, "/")}
+}
+
+// get returns an object, or the error PowerFlex returns when it does not exist.
+func (s *Simulator) get(objectType, id string) (object, error) {
+	obj := s.objects[objectType][id]
+	if obj == nil {
+		return nil, fmt.Errorf("Could not find the %s", displayName(objectType))
+	}
+	return obj, nil
+}
+
+// list returns the objects of a type matching the filter, sorted by ID.
+func (s *Simulator) list(objectType string, filter func(object) bool) []object {
+	objs := []object{}
+	for _, obj := range s.objects[objectType] {
+		if filter == nil || filter(obj) {
+			objs = append(objs, s.render(objectType, obj))
+		}
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i]["id"].(string) < objs[j]["id"].(string) })
+	return objs
+}
+
+// find returns the first object of a type with the given value in a field.
+func (s *Simulator) find(objectType, field string, value interface{}) object {
+	for _, obj := range s.list(objectType, func(obj object) bool { return obj[field] == value }) {
+		return s.objects[objectType][obj["id"].(string)]
+	}
+	return nil
+}
+
+// create adds an object built from the parameters of a POST on the instances of its type.
+func (s *Simulator) create(objectType string, body params) (interface{}, error) {
+	build, ok := creators[objectType]
+	if !ok {
+		return nil, &requestError{http.StatusBadRequest, "Unsupported creation of " + objectType}
+	}
+	obj, err := build(s, body)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"id": s.add(objectType, obj)}, nil
+}
+
+// add stores an object under a new ID and returns the ID.
+func (s *Simulator) add(objectType string, obj object) string {
+	s.lastID++
+	id := fmt.Sprintf("5a5a%012x", s.lastID)
+	if fixed, ok := obj["id"].(string); ok && fixed != "" {
+		id = fixed
+	}
+	obj["id"] = id
+	if s.objects[objectType] == nil {
+		s.objects[objectType] = map[string]object{}
+	}
+	s.objects[objectType][id] = obj
+	return id
+}
+
+// queryIDByKey returns the ID of the object with the given name, or of the SDC with the given IP.
+func (s *Simulator) queryIDByKey(objectType string, body params) (interface{}, error) {
+	var obj object
+	if ip := body.str("ip"); ip != "" {
+		obj = s.find(objectType, "sdcIp", ip)
+	} else {
+		obj = s.find(objectType, "name", body.str("name"))
+	}
+	if obj == nil {
+		return nil, errors.New("Not found")
+	}
+	return obj["id"], nil
+}
+
+// related returns the objects a relationship link of an object points to.
+func (s *Simulator) related(objectType, id, relation string) []object {
+	switch {
+	case objectType == "Sdc" && relation == "Volume":
+		return s.list("Volume", func(volume object) bool { return mappingOf(volume, id) != nil })
+	case objectType == "SnapshotPolicy" && relation == "SourceVolume":
+		return s.list("Volume", func(volume object) bool { return volume["snplIdOfSourceVolume"] == id })
+	case objectType == "StoragePool" && relation == "SpSds":
+		return s.list("Sds", func(sds object) bool {
+			for _, device := range s.objects["Device"] {
+				if device["sdsId"] == sds["id"] && device["storagePoolId"] == id {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	parentField := strings.ToLower(objectType[:1]) + objectType[1:] + "Id"
+	return s.list(relation, func(obj object) bool { return obj[parentField] == id })
+}
+
+// statistics returns the statistics of an object, the simulator only keeps the ones the provider reads.
+func (s *Simulator) statistics(objectType string, obj object) object {
+	stats := object{}
+	switch objectType {
+	case "ReplicationPair":
+		stats["initialCopyProgress"] = obj["initialCopyProgress"]
+	case "StoragePool":
+		stats["capacityAvailableForVolumeAllocationInKb"] = s.availableCapacity(obj)
+	}
+	return stats
+}
+
+// availableCapacity returns the capacity of a storage pool that is left for volume allocation:
+// the capacity of its devices without the spare capacity, halved by the mirroring of the data,
+// less the size of the thick provisioned volumes of the storage pool.
+func (s *Simulator) availableCapacity(pool object) int {
+	capacity := 0
+	for _, device := range s.list("Device", func(obj object) bool { return obj["storagePoolId"] == pool["id"] }) {
+		capacity += device["capacityLimitInKb"].(int)
+	}
+	capacity = capacity * (100 - pool["sparePercentage"].(int)) / 100 / 2
+	for _, volume := range s.list("Volume", func(obj object) bool {
+		return obj["storagePoolId"] == pool["id"] && obj["volumeType"] == "ThickProvisioned"
+	}) {
+		capacity -= volume["sizeInKb"].(int)
+	}
+	if capacity < 0 {
+		return 0
+	}
+	return capacity
+}
+
+// relations lists the relationship links of each object type.
+var relations = map[string][]string{
+	"System":                      {"ProtectionDomain", "Sdc", "SnapshotPolicy", "User"},
+	"ProtectionDomain":            {"StoragePool", "Sds", "FaultSet", "AccelerationPool"},
+	"AccelerationPool":            {"Device"},
+	"FaultSet":                    {"Sds"},
+	"StoragePool":                 {"Volume", "Device", "SpSds", "Statistics"},
+	"Sds":                         {"Device"},
+	"Sdc":                         {"Volume"},
+	"SnapshotPolicy":              {"SourceVolume"},
+	"ReplicationConsistencyGroup": {"ReplicationPair"},
+	"ReplicationPair":             {"Statistics"},
+}
+
+// render returns a copy of the object with its links.
+func (s *Simulator) render(objectType string, obj object) object {
+	rendered := object{}
+	for field, value := range obj {
+		rendered[field] = value
+	}
+	self := fmt.Sprintf("/api/instances/%s::%s", objectType, obj["id"])
+	links := []*scaleiotypes.Link{{Rel: "self", HREF: self}}
+	for _, relation := range relations[objectType] {
+		links = append(links, &scaleiotypes.Link{
+			Rel:  fmt.Sprintf("/api/%s/relationship/%s", objectType, relation),
+			HREF: fmt.Sprintf("%s/relationships/%s", self, relation),
+		})
+	}
+	rendered["links"] = links
+	return rendered
+}
+
+// requestError is an error answered with a status other than the default internal server error.
+type requestError struct {
+	status  int
+	message string
+}
+
+func (e *requestError) Error() string {
+	return e.message
+}
+
+// displayName returns the name of an object type used in the error messages of PowerFlex.
+func displayName(objectType string) string {
+	var name strings.Builder
+	for i, r := range objectType {
+		if i > 0 && r >= 'A' && r <= 'Z' && objectType[i-1] >= 'a' && objectType[i-1] <= 'z' {
+			name.WriteRune(' ')
+		}
+		name.WriteRune(r)
+	}
+	switch objectType {
+	case "Sds", "Sdc", "Mdm":
+		return strings.ToUpper(objectType)
+	}
+	return strings.ToLower(name.String())
+}
+
+// writeJSON answers the request with the value in JSON.
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// writeError answers the request with an error in the format of PowerFlex.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(scaleiotypes.Error{Message: message, HTTPStatusCode: status})
+}
+
+// readParams decodes the JSON body of a request, which is empty for most of the reads.
+func readParams(r *http.Request) (params, error) {
+	body := params{}
+	if r.Body == nil {
+		return body, nil
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return body, nil
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, fmt.Errorf("invalid request body: %s", err.Error())
+	}
+	if body == nil {
+		// the body was null
+		body = params{}
+	}
+	return body, nil
+}
+
+// baseVolume returns the volume at the root of a VTree.
+func (s *Simulator) baseVolume(vtreeID string) object {
+	for _, volume := range s.objects["Volume"] {
+		if volume["vtreeId"] == vtreeID && volume["ancestorVolumeId"] == "" {
+			return volume
+		}
+	}
+	return nil
+}
+
+// vtree returns a VTree, running its pending migration to completion.
+func (s *Simulator) vtree(id string) (interface{}, error) {
+	base := s.baseVolume(id)
+	if base == nil {
+		return nil, errors.New("Could not find the VTree")
+	}
+	info := s.migrations[id]
+	if info == nil {
+		info = object{"migrationStatus": "NotInMigration", "migrationPauseReason": "None"}
+	}
+	if info["migrationStatus"] == "MigrationNormal" {
+		pool := s.objects["StoragePool"][info["destinationStoragePoolId"].(string)]
+		for _, volume := range s.objects["Volume"] {
+			if volume["vtreeId"] == id {
+				volume["storagePoolId"] = pool["id"]
+				volume["dataLayout"] = pool["dataLayout"]
+				volume["volumeType"] = info["volumeType"]
+				volume["compressionMethod"] = info["compressionMethod"]
+			}
+		}
+		info["migrationStatus"] = "NotInMigration"
+	}
+	return object{
+		"id":            id,
+		"name":          "",
+		"baseVolumeId":  base["id"],
+		"storagePoolId": base["storagePoolId"],
+		"vtreeMigrationInfo": object{
+			"sourceStoragePoolId":      info["sourceStoragePoolId"],
+			"destinationStoragePoolId": info["destinationStoragePoolId"],
+			"migrationStatus":          info["migrationStatus"],
+			"migrationPauseReason":     info["migrationPauseReason"],
+			"thicknessConversionType":  info["thicknessConversionType"],
+		},
+	}, nil
+}
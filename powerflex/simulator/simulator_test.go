@@ -0,0 +1,1007 @@
+/*
+Copyright (c) 2023 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-provider-powerflex/client"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+// connect starts a simulator and returns a client logged in to it.
+func connect(t *testing.T) (*Simulator, *goscaleio.Client, *goscaleio.System) {
+	t.Helper()
+	sim := New()
+	endpoint := sim.Start()
+	t.Cleanup(sim.Close)
+
+	c, err := goscaleio.NewClientWithArgs(endpoint, "", 10, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Authenticate(&goscaleio.ConfigConnect{Endpoint: endpoint, Username: sim.Username, Password: sim.Password}); err != nil {
+		t.Fatal(err)
+	}
+	system, err := c.FindSystem(SystemID, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sim, c, system
+}
+
+// expectError fails the test unless err contains the message.
+func expectError(t *testing.T, err error, message string) {
+	t.Helper()
+	if err == nil || !strings.Contains(err.Error(), message) {
+		t.Errorf("got error %v, want %q", err, message)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	sim := New()
+	endpoint := sim.Start()
+	defer sim.Close()
+
+	c, err := goscaleio.NewClientWithArgs(endpoint, "", 10, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.Authenticate(&goscaleio.ConfigConnect{Endpoint: endpoint, Username: sim.Username, Password: "wrong"})
+	expectError(t, err, "Unauthorized")
+	if _, err := c.GetSystems(); err == nil {
+		t.Error("the requests should be rejected without a session")
+	}
+}
+
+func TestStorageObjects(t *testing.T) {
+	sim, c, system := connect(t)
+
+	pdID, err := system.CreateProtectionDomain("tf_pd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = system.CreateProtectionDomain("tf_pd")
+	expectError(t, err, "name already in use")
+	pd, err := system.FindProtectionDomain(pdID, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pdClient := goscaleio.NewProtectionDomainEx(c, pd)
+	if err := pdClient.SetName("tf pd"); err == nil {
+		t.Error("a name with a space should be rejected")
+	}
+	expectError(t, pdClient.SetRfcacheParams(scaleiotypes.PDRfCacheParams{RfCachePageSizeKb: 12}), "Invalid RFcache page size")
+
+	poolID, err := pdClient.CreateStoragePool(&scaleiotypes.StoragePoolParam{Name: "tf_pool", ProtectionDomainID: pdID, MediaType: "SSD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pdClient.SetSparePercentage(poolID, "20"); err != nil {
+		t.Fatal(err)
+	}
+	pool, err := pdClient.FindStoragePool(poolID, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pool.SparePercentage != 20 || pool.MediaType != "SSD" {
+		t.Errorf("unexpected storage pool %+v", pool)
+	}
+
+	sdsID, err := pdClient.CreateSdsWithParams(&scaleiotypes.Sds{Name: "tf_sds", IPList: []*scaleiotypes.SdsIP{{IP: "192.0.2.200", Role: "all"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = pdClient.CreateSdsWithParams(&scaleiotypes.Sds{Name: "tf_sds_2", IPList: []*scaleiotypes.SdsIP{{IP: "192.0.2.200", Role: "all"}}})
+	expectError(t, err, "The SDS IP address and port already in use")
+	if err := pdClient.AddSdSIP(sdsID, "192.0.2.201", "sdcOnly"); err != nil {
+		t.Fatal(err)
+	}
+	sds, err := system.GetSdsByID(sdsID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sds.IPList) != 2 || sds.IPList[1].Role != "sdcOnly" {
+		t.Errorf("unexpected IPs %+v", sds.IPList)
+	}
+
+	poolClient := goscaleio.NewStoragePoolEx(c, pool)
+	_, err = poolClient.AttachDevice(&scaleiotypes.DeviceParam{DeviceCurrentPathname: "/dev/sdc", StoragePoolID: poolID, SdsID: sdsID, MediaType: "HDD"})
+	expectError(t, err, "not compatible with the Storage Pool media type")
+	deviceID, err := poolClient.AttachDevice(&scaleiotypes.DeviceParam{Name: "tf_device", DeviceCurrentPathname: "/dev/sdc", StoragePoolID: poolID, SdsID: sdsID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devices, err := poolClient.GetDevice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 1 || devices[0].ID != deviceID {
+		t.Errorf("unexpected devices %+v", devices)
+	}
+	spSds, err := poolClient.GetSDSStoragePool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spSds) != 1 || spSds[0].ID != sdsID {
+		t.Errorf("unexpected SDSs of the storage pool %+v", spSds)
+	}
+
+	expectError(t, system.DeleteProtectionDomain("tf_pd"), "cannot be removed")
+	if err := pdClient.DeleteSds(sdsID); err != nil {
+		t.Fatal(err)
+	}
+	if sim.Exists("Device", deviceID) {
+		t.Error("the devices should be removed with their SDS")
+	}
+	if err := pdClient.DeleteStoragePool("tf_pool"); err != nil {
+		t.Fatal(err)
+	}
+	if err := system.DeleteProtectionDomain("tf_pd"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = system.FindProtectionDomain(pdID, "", "")
+	expectError(t, err, "Couldn't find protection domain")
+}
+
+func TestVolumesSnapshotsAndMappings(t *testing.T) {
+	sim, c, system := connect(t)
+
+	resp, err := c.CreateVolume(&scaleiotypes.VolumeParam{Name: "tf_volume", VolumeSizeInKb: "1048576"}, "pool1", ProtectionDomainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	volumes, err := c.GetVolume("", "", "", "tf_volume", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(volumes) != 1 || volumes[0].ID != resp.ID || volumes[0].SizeInKb != 8*1024*1024 {
+		t.Fatalf("unexpected volumes %+v", volumes)
+	}
+	if missing, err := c.GetVolume("", "", "", "missing", false); err != nil || len(missing) != 0 {
+		t.Errorf("got %v and %v for a missing volume, want nothing", missing, err)
+	}
+
+	volume := goscaleio.NewVolume(c)
+	volume.Volume = volumes[0]
+	expectError(t, volume.SetVolumeName("tf-unknown-test-donot-delete"), "Volume name already in use")
+	expectError(t, volume.SetVolumeSize("2048"), "Requested volume size exceeds the volume allocation limit")
+	if err := volume.SetVolumeSize("16"); err != nil {
+		t.Fatal(err)
+	}
+	expectError(t, volume.SetVolumeSize("8"), "Volume capacity can only be increased")
+	expectError(t, volume.SetCompressionMethod("Normal"), "fine granularity")
+
+	if err := volume.MapVolumeSdc(&scaleiotypes.MapVolumeSdcParam{SdcID: SdcID}); err != nil {
+		t.Fatal(err)
+	}
+	expectError(t, volume.SetMappedSdcLimits(&scaleiotypes.SetMappedSdcLimitsParam{SdcID: SdcID, IopsLimit: "5"}), "IOPS limit")
+	if err := volume.SetMappedSdcLimits(&scaleiotypes.SetMappedSdcLimitsParam{SdcID: SdcID, IopsLimit: "100", BandwidthLimitInKbps: "2048"}); err != nil {
+		t.Fatal(err)
+	}
+	sdc, err := system.GetSdcByID(SdcID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mapped, err := sdc.GetVolume()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mapped) != 1 || mapped[0].MappedSdcInfo[0].LimitIops != 100 || mapped[0].MappedSdcInfo[0].LimitBwInMbps != 2 {
+		t.Errorf("unexpected mapped volumes %+v", mapped)
+	}
+
+	snapResp, err := system.CreateSnapshotConsistencyGroup(&scaleiotypes.SnapshotVolumesParam{
+		SnapshotDefs: []*scaleiotypes.SnapshotDef{{VolumeID: resp.ID, SnapshotName: "tf_snap_1"}, {VolumeID: VolumeID, SnapshotName: "tf_snap_2"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapResp.VolumeIDList) != 2 || snapResp.SnapshotGroupID == "" {
+		t.Fatalf("unexpected snapshots %+v", snapResp)
+	}
+	snapshots, err := c.GetVolume("", "", resp.ID, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 || snapshots[0].Name != "tf_snap_1" || snapshots[0].VolumeType != "Snapshot" {
+		t.Errorf("unexpected snapshots %+v", snapshots)
+	}
+	snapshot := goscaleio.NewVolume(c)
+	snapshot.Volume = snapshots[0]
+	expectError(t, snapshot.LockAutoSnapshot(), "is not an auto-snapshot")
+
+	expectError(t, volume.RemoveVolume("INCLUDING_DESCENDANTS"), "mapped")
+	if err := volume.UnmapVolumeSdc(&scaleiotypes.UnmapVolumeSdcParam{SdcID: SdcID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := volume.RemoveVolume("INCLUDING_DESCENDANTS"); err != nil {
+		t.Fatal(err)
+	}
+	if sim.Exists("Volume", snapResp.VolumeIDList[0]) || !sim.Exists("Volume", snapResp.VolumeIDList[1]) {
+		t.Error("only the snapshots of the removed volume should be removed")
+	}
+	_, err = c.GetVolume("", resp.ID, "", "", false)
+	expectError(t, err, "Could not find the volume")
+
+	if err := client.RemoveConsistencyGroupSnapshots(context.Background(), c, SystemID, snapResp.SnapshotGroupID); err != nil {
+		t.Fatal(err)
+	}
+	if sim.Exists("Volume", snapResp.VolumeIDList[1]) {
+		t.Error("the snapshots of the snapshot group should be removed")
+	}
+	expectError(t, client.RemoveConsistencyGroupSnapshots(context.Background(), c, SystemID, snapResp.SnapshotGroupID), "Could not find the snapshot group")
+}
+
+func TestSdcsAndSnapshotPolicies(t *testing.T) {
+	_, c, system := connect(t)
+
+	sdc, err := system.FindSdc("SdcIP", "192.0.2.11")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sdc.Sdc.ID != MappingSdcID {
+		t.Errorf("got SDC %s, want %s", sdc.Sdc.ID, MappingSdcID)
+	}
+	if _, err := system.ChangeSdcName(MappingSdcID, "terraform_sdc_2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := system.ChangeSdcPerfProfile(MappingSdcID, "Compact"); err != nil {
+		t.Fatal(err)
+	}
+	sdc, err = system.GetSdcByID(MappingSdcID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sdc.Sdc.Name != "terraform_sdc_2" || sdc.Sdc.PerfProfile != "Compact" {
+		t.Errorf("unexpected SDC %+v", sdc.Sdc)
+	}
+	if err := system.DeleteSdc(RenamedSdcID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = system.GetSdcByID(RenamedSdcID)
+	expectError(t, err, "Could not find the SDC")
+
+	policies, err := c.GetSnapshotPolicy("sample_snap_policy_1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(policies) != 1 || policies[0].ID != SnapshotPolicyID {
+		t.Errorf("unexpected snapshot policies %+v", policies)
+	}
+}
+
+func TestSnapshotPolicyLifecycle(t *testing.T) {
+	_, c, _ := connect(t)
+	ctx := context.Background()
+
+	_, err := client.CreateSnapshotPolicy(ctx, c, &client.SnapshotPolicyCreateParam{
+		Name: "tf_policy", AutoSnapshotCreationCadenceInMin: "60", NumOfRetainedSnapshotsPerLevel: []string{"50", "20"},
+	})
+	expectError(t, err, "exceeds the maximum of 60")
+	id, err := client.CreateSnapshotPolicy(ctx, c, &client.SnapshotPolicyCreateParam{
+		Name: "tf_policy", AutoSnapshotCreationCadenceInMin: "60", NumOfRetainedSnapshotsPerLevel: []string{"4", "2"}, Paused: "true",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ModifySnapshotPolicy(ctx, c, id, &client.SnapshotPolicyModifyParam{AutoSnapshotCreationCadenceInMin: "30", NumOfRetainedSnapshotsPerLevel: []string{"6"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ResumeSnapshotPolicy(ctx, c, id); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.AddSourceVolumeToSnapshotPolicy(ctx, c, id, VolumeID); err != nil {
+		t.Fatal(err)
+	}
+	expectError(t, client.AddSourceVolumeToSnapshotPolicy(ctx, c, SnapshotPolicyID, VolumeID), "already attached")
+
+	policies, err := c.GetSnapshotPolicy("", id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := policies[0]
+	if policy.SnapshotPolicyState != "Active" || policy.AutoSnapshotCreationCadenceInMin != 30 ||
+		len(policy.NumOfRetainedSnapshotsPerLevel) != 1 || policy.NumOfSourceVolumes != 1 {
+		t.Errorf("unexpected snapshot policy %+v", policy)
+	}
+	volumes, err := client.GetSnapshotPolicySourceVolumes(ctx, c, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(volumes) != 1 || volumes[0].ID != VolumeID {
+		t.Errorf("unexpected source volumes %+v", volumes)
+	}
+
+	expectError(t, client.RemoveSnapshotPolicy(ctx, c, id), "has source volumes")
+	if err := client.RemoveSourceVolumeFromSnapshotPolicy(ctx, c, id, VolumeID, "Detach"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.RemoveSnapshotPolicy(ctx, c, id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.GetSnapshotPolicy("", id)
+	expectError(t, err, "Could not find the snapshot policy")
+}
+
+func TestFaultSetLifecycle(t *testing.T) {
+	_, c, system := connect(t)
+	ctx := context.Background()
+
+	_, err := client.CreateFaultSet(ctx, c, &client.FaultSetCreateParam{Name: "fs1", ProtectionDomainID: "invalid"})
+	expectError(t, err, "Could not find the protection domain")
+	id, err := client.CreateFaultSet(ctx, c, &client.FaultSetCreateParam{Name: "fs1", ProtectionDomainID: ProtectionDomainID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CreateFaultSet(ctx, c, &client.FaultSetCreateParam{Name: "fs1", ProtectionDomainID: ProtectionDomainID})
+	expectError(t, err, "Fault set name already in use")
+	if err := client.RenameFaultSet(ctx, c, id, "fs2"); err != nil {
+		t.Fatal(err)
+	}
+	faultSet, err := client.FindProtectionDomainFaultSet(ctx, c, ProtectionDomainID, "fs2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if faultSet.ID != id || faultSet.ProtectionDomainID != ProtectionDomainID {
+		t.Errorf("unexpected fault set %+v", faultSet)
+	}
+
+	sdsID, err := client.CreateSdsInFaultSet(ctx, c, ProtectionDomainID, id, &scaleiotypes.Sds{
+		Name:   "sds-in-fault-set",
+		IPList: []*scaleiotypes.SdsIP{{IP: "192.0.2.200", Role: "all"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdsList, err := client.GetFaultSetSds(ctx, c, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sdsList) != 1 || sdsList[0].ID != sdsID || sdsList[0].FaultSetID != id {
+		t.Errorf("unexpected SDSs of the fault set %+v", sdsList)
+	}
+
+	expectError(t, client.RemoveFaultSet(ctx, c, id), "cannot be removed while it has SDSs")
+	pd, err := system.FindProtectionDomain(ProtectionDomainID, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	protectionDomain := goscaleio.NewProtectionDomainEx(c, pd)
+	if err := protectionDomain.DeleteSds(sdsID); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.RemoveFaultSet(ctx, c, id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetFaultSet(ctx, c, id)
+	expectError(t, err, "Could not find the fault set")
+}
+
+func TestAccelerationPoolLifecycle(t *testing.T) {
+	_, c, _ := connect(t)
+	ctx := context.Background()
+
+	_, err := client.CreateAccelerationPool(ctx, c, &client.AccelerationPoolCreateParam{Name: "accp1", ProtectionDomainID: ProtectionDomainID, MediaType: "HDD"})
+	expectError(t, err, "Invalid acceleration pool media type")
+	id, err := client.CreateAccelerationPool(ctx, c, &client.AccelerationPoolCreateParam{Name: "accp1", ProtectionDomainID: ProtectionDomainID, MediaType: client.AccelerationPoolMediaTypeNvdimm})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CreateAccelerationPool(ctx, c, &client.AccelerationPoolCreateParam{Name: "accp1", ProtectionDomainID: ProtectionDomainID, MediaType: client.AccelerationPoolMediaTypeSsd})
+	expectError(t, err, "Acceleration pool name already in use")
+	if err := client.RenameAccelerationPool(ctx, c, id, "accp2"); err != nil {
+		t.Fatal(err)
+	}
+	pool, err := client.GetAccelerationPool(ctx, c, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pool.Name != "accp2" || pool.ProtectionDomainID != ProtectionDomainID || pool.MediaType != client.AccelerationPoolMediaTypeNvdimm {
+		t.Errorf("unexpected acceleration pool %+v", pool)
+	}
+
+	_, err = client.AddAccelerationDevice(ctx, c, &client.AccelerationDeviceParam{
+		DeviceCurrentPathname: "/dev/pmem0",
+		AccelerationPoolID:    id,
+		SdsID:                 SdsID,
+		MediaType:             "SSD",
+	})
+	expectError(t, err, "not compatible with the Acceleration Pool media type")
+	deviceID, err := client.AddAccelerationDevice(ctx, c, &client.AccelerationDeviceParam{
+		Name:                  "nvdimm1",
+		DeviceCurrentPathname: "/dev/pmem0",
+		AccelerationPoolID:    id,
+		SdsID:                 SdsID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devices, err := client.GetAccelerationPoolDevices(ctx, c, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 1 || devices[0].ID != deviceID || devices[0].AccelerationPoolID != id || devices[0].MediaType != client.AccelerationPoolMediaTypeNvdimm {
+		t.Errorf("unexpected devices of the acceleration pool %+v", devices)
+	}
+
+	expectError(t, client.RemoveAccelerationPool(ctx, c, id), "cannot be removed while it has devices")
+	if err := client.RemoveAccelerationDevice(ctx, c, deviceID); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.RemoveAccelerationPool(ctx, c, id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetAccelerationPool(ctx, c, id)
+	expectError(t, err, "Could not find the acceleration pool")
+}
+
+func TestReplicationLifecycle(t *testing.T) {
+	_, c, _ := connect(t)
+	ctx := context.Background()
+
+	_, err := client.CreatePeerMdm(ctx, c, &client.PeerMdmCreateParam{Name: "peer1", PeerSystemID: RemoteSystemID})
+	expectError(t, err, "At least one IP address is required")
+	peerID, err := client.CreatePeerMdm(ctx, c, &client.PeerMdmCreateParam{
+		Name:          "peer1",
+		PeerSystemID:  RemoteSystemID,
+		PeerSystemIps: []string{"192.0.2.200"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.CreatePeerMdm(ctx, c, &client.PeerMdmCreateParam{
+		PeerSystemID:  RemoteSystemID,
+		PeerSystemIps: []string{"192.0.2.201"},
+	})
+	expectError(t, err, "already registered")
+	if err := client.ModifyPeerMdmIPs(ctx, c, peerID, []string{"192.0.2.201", "192.0.2.202"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ModifyPeerMdmPort(ctx, c, peerID, 7612); err != nil {
+		t.Fatal(err)
+	}
+	peer, err := client.GetPeerMdm(ctx, c, peerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peer.Port != 7612 || len(peer.IPList) != 2 || peer.IPList[1].IP != "192.0.2.202" || peer.PeerSystemID != RemoteSystemID {
+		t.Errorf("unexpected peer MDM %+v", peer)
+	}
+
+	param := &scaleiotypes.ReplicationConsistencyGroupCreatePayload{
+		Name:                     "rcg1",
+		RpoInSeconds:             "5",
+		ProtectionDomainID:       ProtectionDomainID,
+		RemoteProtectionDomainID: RemoteProtectionDomainID,
+		PeerMdmID:                peerID,
+	}
+	_, err = c.CreateReplicationConsistencyGroup(param)
+	expectError(t, err, "The RPO must be between")
+	param.RpoInSeconds = "60"
+	rcgResp, err := c.CreateReplicationConsistencyGroup(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := rcgResp.ID
+	group := goscaleio.NewReplicationConsistencyGroup(c)
+	group.ReplicationConsistencyGroup.ID = id
+	if err := client.RenameReplicationConsistencyGroup(ctx, c, id, "rcg2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ModifyReplicationConsistencyGroupRpo(ctx, c, id, 120); err != nil {
+		t.Fatal(err)
+	}
+	if err := group.ExecutePauseOnReplicationGroup(); err != nil {
+		t.Fatal(err)
+	}
+	rcg, err := c.GetReplicationConsistencyGroupByID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// goscaleio removes the replication consistency group through its self link
+	group.ReplicationConsistencyGroup = rcg
+	if rcg.Name != "rcg2" || rcg.RpoInSeconds != 120 || rcg.PauseMode != client.PauseModeStopDataTransfer ||
+		rcg.DestinationSystemID != RemoteSystemID || rcg.PeerMdmID != peerID {
+		t.Errorf("unexpected replication consistency group %+v", rcg)
+	}
+
+	if err := group.ExecuteResumeOnReplicationGroup(); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.TerminateReplicationConsistencyGroup(ctx, c, id); err != nil {
+		t.Fatal(err)
+	}
+	expectError(t, group.ExecutePauseOnReplicationGroup(), "is not active")
+	if err := client.ActivateReplicationConsistencyGroup(ctx, c, id); err != nil {
+		t.Fatal(err)
+	}
+
+	pairParam := &scaleiotypes.QueryReplicationPair{
+		Name:                          "pair1",
+		SourceVolumeID:                VolumeID,
+		DestinationVolumeID:           RemoteVolumeID,
+		ReplicationConsistencyGroupID: id,
+		CopyType:                      "OnlineCopy",
+	}
+	pair, err := c.CreateReplicationPair(pairParam)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairID := pair.ID
+	_, err = c.CreateReplicationPair(pairParam)
+	expectError(t, err, "already replicated")
+	pairs, err := group.GetReplicationPairs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pairs) != 1 || pairs[0].ID != pairID {
+		t.Fatalf("unexpected replication pairs %+v", pairs)
+	}
+	pair = pairs[0]
+	if pair.LocalVolumeID != VolumeID || pair.RemoteVolumeID != RemoteVolumeID || pair.ReplicationConsistencyGroupID != id ||
+		pair.InitialCopyState != "Done" || pair.PeerSystemName != "peer1" {
+		t.Errorf("unexpected replication pair %+v", pair)
+	}
+	rp := goscaleio.NewReplicationPair(c)
+	rp.ReplicaitonPair = pair
+	stats, err := rp.GetReplicationPairStatistics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.InitialCopyProgress != 1 {
+		t.Errorf("unexpected replication pair statistics %+v", stats)
+	}
+
+	expectError(t, group.ExecuteRestoreOnReplicationGroup(), "is not failed over")
+	if err := group.ExecuteFailoverOnReplicationGroup(); err != nil {
+		t.Fatal(err)
+	}
+	expectError(t, group.ExecuteSwitchoverOnReplicationGroup(false), "is already failed over")
+	if err := group.ExecuteReverseOnReplicationGroup(); err != nil {
+		t.Fatal(err)
+	}
+	if err := group.FreezeReplicationConsistencyGroup(id); err != nil {
+		t.Fatal(err)
+	}
+	rcg, err = c.GetReplicationConsistencyGroupByID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rcg.FailoverType != client.FailoverTypeNone || rcg.ReplicationDirection != client.DirectionRemoteToLocal ||
+		rcg.FreezeState != client.FreezeStateFrozen {
+		t.Errorf("unexpected replication consistency group %+v", rcg)
+	}
+	if err := client.UnfreezeReplicationConsistencyGroup(ctx, c, id); err != nil {
+		t.Fatal(err)
+	}
+
+	expectError(t, group.RemoveReplicationConsistencyGroup(false), "while it has replication pairs")
+	if _, err := rp.RemoveReplicationPair(false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetVolume("", VolumeID, "", "", false); err != nil {
+		t.Errorf("the source volume should be kept: %s", err)
+	}
+
+	expectError(t, client.RemovePeerMdm(ctx, c, peerID), "used by replication consistency groups")
+	if err := group.RemoveReplicationConsistencyGroup(false); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.GetReplicationConsistencyGroupByID(id)
+	expectError(t, err, "Could not find the replication consistency group")
+	if err := client.RemovePeerMdm(ctx, c, peerID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetPeerMdm(ctx, c, peerID)
+	expectError(t, err, "Could not find the peer mdm")
+}
+
+func TestMdmCluster(t *testing.T) {
+	_, c, _ := connect(t)
+	ctx := context.Background()
+
+	cluster, err := client.GetMdmCluster(ctx, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster.ClusterMode != client.ClusterModeThreeNodes || cluster.PrimaryMdm.IPs[0] != "192.0.2.1" ||
+		len(cluster.SecondaryMdms) != 1 || len(cluster.TieBreakers) != 1 || len(cluster.StandbyMdms) != 0 {
+		t.Fatalf("unexpected MDM cluster %+v", cluster)
+	}
+	secondaryID := cluster.SecondaryMdms[0].ID
+
+	_, err = client.AddStandbyMdm(ctx, c, &client.StandbyMdmParam{IPs: []string{"192.0.2.2"}, Role: client.MdmRoleManager})
+	expectError(t, err, "already used by an MDM")
+	managerID, err := client.AddStandbyMdm(ctx, c, &client.StandbyMdmParam{
+		IPs:           []string{"192.0.2.20"},
+		ManagementIPs: []string{"192.0.2.20"},
+		Role:          client.MdmRoleManager,
+		Name:          "mdm3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tbID, err := client.AddStandbyMdm(ctx, c, &client.StandbyMdmParam{IPs: []string{"192.0.2.21"}, Role: client.MdmRoleTieBreaker})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectError(t, client.SwitchClusterMode(ctx, c, &client.SwitchClusterModeParam{
+		Mode:             client.ClusterModeFiveNodes,
+		AddSecondaryMdms: []string{managerID},
+	}), "requires 2 secondary MDMs and 2 tie-breakers")
+	expectError(t, client.SwitchClusterMode(ctx, c, &client.SwitchClusterModeParam{
+		Mode:             client.ClusterModeFiveNodes,
+		AddSecondaryMdms: []string{tbID},
+		AddTBs:           []string{managerID},
+	}), "does not have the Manager role")
+	if err := client.SwitchClusterMode(ctx, c, &client.SwitchClusterModeParam{
+		Mode:             client.ClusterModeFiveNodes,
+		AddSecondaryMdms: []string{managerID},
+		AddTBs:           []string{tbID},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	expectError(t, client.RemoveStandbyMdm(ctx, c, managerID), "is not a standby MDM")
+
+	if err := client.ChangeMdmOwnership(ctx, c, secondaryID); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.RenameMdm(ctx, c, managerID, "mdm4"); err != nil {
+		t.Fatal(err)
+	}
+	cluster, err = client.GetMdmCluster(ctx, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster.ClusterMode != client.ClusterModeFiveNodes || cluster.PrimaryMdm.ID != secondaryID ||
+		len(cluster.SecondaryMdms) != 2 || len(cluster.TieBreakers) != 2 {
+		t.Errorf("unexpected MDM cluster %+v", cluster)
+	}
+	system, err := c.FindSystem(SystemID, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if system.System.MdmMode != client.ClusterModeFiveNodes || system.System.PrimaryActorIPList[0] != "192.0.2.2" {
+		t.Errorf("unexpected system %+v", system.System)
+	}
+
+	if err := client.SwitchClusterMode(ctx, c, &client.SwitchClusterModeParam{
+		Mode:                client.ClusterModeThreeNodes,
+		RemoveSecondaryMdms: []string{managerID},
+		RemoveTBs:           []string{tbID},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{managerID, tbID} {
+		if err := client.RemoveStandbyMdm(ctx, c, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cluster, err = client.GetMdmCluster(ctx, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster.ClusterMode != client.ClusterModeThreeNodes || len(cluster.StandbyMdms) != 0 {
+		t.Errorf("unexpected MDM cluster %+v", cluster)
+	}
+}
+
+func TestUserLifecycle(t *testing.T) {
+	sim, c, system := connect(t)
+	ctx := context.Background()
+
+	_, err := client.CreateUser(ctx, c, &client.UserCreateParam{Name: "monitor1", UserRole: "Reader", Password: "Password123!"})
+	expectError(t, err, "Invalid user role")
+	_, err = client.CreateUser(ctx, c, &client.UserCreateParam{Name: sim.Username, UserRole: client.UserRoleMonitor, Password: "Password123!"})
+	expectError(t, err, "User name already in use")
+	id, err := client.CreateUser(ctx, c, &client.UserCreateParam{Name: "monitor1", UserRole: client.UserRoleMonitor, Password: "Password123!"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := client.GetUser(ctx, c, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Name != "monitor1" || user.UserRole != client.UserRoleMonitor || !user.PasswordChangeRequire || user.SystemID != SystemID {
+		t.Errorf("unexpected user %+v", user)
+	}
+
+	if err := client.SetUserRole(ctx, c, id, client.UserRoleConfigure); err != nil {
+		t.Fatal(err)
+	}
+	sim.objects["User"][id]["passwordChangeRequired"] = false
+	if err := client.ResetUserPassword(ctx, c, id, "Password456!"); err != nil {
+		t.Fatal(err)
+	}
+	users, err := system.GetUser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 || users[1].ID != id || users[1].UserRole != client.UserRoleConfigure || !users[1].PasswordChangeRequire {
+		t.Errorf("unexpected users %+v", users)
+	}
+
+	expectError(t, client.RemoveUser(ctx, c, users[0].ID), "The current user cannot be removed")
+	if err := client.RemoveUser(ctx, c, id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetUser(ctx, c, id)
+	expectError(t, err, "Could not find the user")
+}
+
+func TestSystemSettings(t *testing.T) {
+	_, c, _ := connect(t)
+	ctx := context.Background()
+
+	settings, err := client.GetSystemSettings(ctx, c, SystemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.RestrictedSdcMode != client.RestrictedSdcModeNone || settings.CapacityAlertHighThresholdPercent != 80 ||
+		settings.CapacityAlertCriticalThresholdPercent != 90 || !settings.CliPasswordAllowed {
+		t.Fatalf("unexpected system settings %+v", settings)
+	}
+
+	expectError(t, client.SetRestrictedSdcMode(ctx, c, SystemID, "Ip"), "Invalid restricted SDC mode")
+	if err := client.SetRestrictedSdcMode(ctx, c, SystemID, client.RestrictedSdcModeApprovedIP); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SetSdcApprovedIps(ctx, c, SystemID, SdcID, []string{"192.0.2.10", "192.0.2.30"}); err != nil {
+		t.Fatal(err)
+	}
+	expectError(t, client.SetSdcApprovedIps(ctx, c, SystemID, "invalid", []string{"192.0.2.10"}), "Could not find the SDC")
+	expectError(t, client.SetSystemCapacityAlertThresholds(ctx, c, SystemID, 90, 85), "must be lower than the critical threshold")
+	if err := client.SetSystemCapacityAlertThresholds(ctx, c, SystemID, 70, 85); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SetCliPasswordAllowed(ctx, c, SystemID, false); err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err = client.GetSystemSettings(ctx, c, SystemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !settings.RestrictedSdcModeEnabled || settings.RestrictedSdcMode != client.RestrictedSdcModeApprovedIP ||
+		settings.CapacityAlertHighThresholdPercent != 70 || settings.CapacityAlertCriticalThresholdPercent != 85 ||
+		settings.CliPasswordAllowed {
+		t.Errorf("unexpected system settings %+v", settings)
+	}
+	sdcs, err := client.GetSdcApprovedIps(ctx, c, SystemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sdc := range sdcs {
+		if sdc.ID == SdcID && len(sdc.SdcApprovedIps) != 2 || sdc.ID != SdcID && len(sdc.SdcApprovedIps) != 0 {
+			t.Errorf("unexpected approved IPs %+v", sdc)
+		}
+	}
+}
+
+func TestSdcApproval(t *testing.T) {
+	_, c, system := connect(t)
+	ctx := context.Background()
+
+	_, err := client.ApproveSdc(ctx, c, SystemID, &client.ApproveSdcParam{SdcGUID: "6F1D1A70-3A1E-4D26-9E24-0B2D8C1F0009"})
+	expectError(t, err, "not in Guid restricted SDC mode")
+	if err := client.SetRestrictedSdcMode(ctx, c, SystemID, client.RestrictedSdcModeGUID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.ApproveSdc(ctx, c, SystemID, &client.ApproveSdcParam{})
+	expectError(t, err, "Either the SDC GUID or the SDC IP is required")
+	_, err = client.ApproveSdc(ctx, c, SystemID, &client.ApproveSdcParam{SdcGUID: "6F1D1A70-3A1E-4D26-9E24-0B2D8C1F0001"})
+	expectError(t, err, "The SDC is already approved")
+	id, err := client.ApproveSdc(ctx, c, SystemID, &client.ApproveSdcParam{SdcGUID: "6F1D1A70-3A1E-4D26-9E24-0B2D8C1F0009", Name: "new-host"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdc, err := system.GetSdcByID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sdc.Sdc.Name != "new-host" || !sdc.Sdc.SdcApproved || sdc.Sdc.MdmConnectionState != "Disconnected" {
+		t.Errorf("unexpected SDC %+v", sdc.Sdc)
+	}
+
+	if err := client.SetRestrictedSdcMode(ctx, c, SystemID, client.RestrictedSdcModeApprovedIP); err != nil {
+		t.Fatal(err)
+	}
+	id, err = client.ApproveSdc(ctx, c, SystemID, &client.ApproveSdcParam{SdcIP: "192.0.2.40"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sdcs, err := client.GetSdcApprovedIps(ctx, c, SystemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sdc := range sdcs {
+		if sdc.ID == id && (len(sdc.SdcApprovedIps) != 1 || sdc.SdcApprovedIps[0] != "192.0.2.40") {
+			t.Errorf("unexpected approved IPs %+v", sdc)
+		}
+	}
+	if err := system.DeleteSdc(id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = system.GetSdcByID(id)
+	expectError(t, err, "Could not find the SDC")
+}
+
+func TestVTreeMigration(t *testing.T) {
+	_, c, _ := connect(t)
+	ctx := context.Background()
+
+	resp, err := c.CreateVolume(&scaleiotypes.VolumeParam{Name: "tf_migrated", VolumeSizeInKb: "8388608", VolumeType: "ThickProvisioned"}, "pool1", ProtectionDomainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	volumes, err := c.GetVolume("", resp.ID, "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vtreeID := volumes[0].VTreeID
+
+	expectError(t, client.MigrateVTree(ctx, c, resp.ID, &client.VTreeMigrationParam{DestSPID: StoragePoolID}), "already in the destination storage pool")
+	expectError(t, client.MigrateVTree(ctx, c, resp.ID, &client.VTreeMigrationParam{DestSPID: FineStoragePoolID}), "only support thin provisioned volumes")
+	expectError(t, client.MigrateVTree(ctx, c, resp.ID, &client.VTreeMigrationParam{DestSPID: StoragePoolID, VolTypeConversion: client.VTreeConversionThinToThick}), "Invalid volume type conversion")
+	if err := client.MigrateVTree(ctx, c, resp.ID, &client.VTreeMigrationParam{
+		DestSPID:          FineStoragePoolID,
+		VolTypeConversion: client.VTreeConversionThickToThin,
+		CompressionMethod: "Normal",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	expectError(t, client.MigrateVTree(ctx, c, resp.ID, &client.VTreeMigrationParam{DestSPID: StoragePoolID}), "already being migrated")
+
+	// the volume stays in its storage pool until the migration completes
+	volumes, err = c.GetVolume("", resp.ID, "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if volumes[0].StoragePoolID != StoragePoolID {
+		t.Errorf("volume moved to %s before the migration completed", volumes[0].StoragePoolID)
+	}
+	vtree, err := client.GetVTree(ctx, c, vtreeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vtree.StoragePoolID != FineStoragePoolID || vtree.BaseVolumeID != resp.ID ||
+		vtree.MigrationInfo.MigrationStatus != client.VTreeNotInMigration ||
+		vtree.MigrationInfo.ThicknessConversionType != client.VTreeConversionThickToThin {
+		t.Errorf("unexpected VTree %+v", vtree)
+	}
+	volumes, err = c.GetVolume("", resp.ID, "", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if volumes[0].StoragePoolID != FineStoragePoolID || volumes[0].VolumeType != "ThinProvisioned" || volumes[0].CompressionMethod != "Normal" {
+		t.Errorf("unexpected volume after the migration %+v", volumes[0])
+	}
+
+	_, err = client.GetVTree(ctx, c, "missing")
+	expectError(t, err, "Could not find the VTree")
+}
+
+func TestStoragePoolStatistics(t *testing.T) {
+	_, c, system := connect(t)
+
+	pool, err := system.GetStoragePoolByID(StoragePoolID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// three devices of 512 GB, with 10% of spare capacity and two copies of the data
+	available := 3 * deviceCapacityInGB * kbPerGB * 90 / 100 / 2
+	stats, err := goscaleio.NewStoragePoolEx(c, pool).GetStatistics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.CapacityAvailableForVolumeAllocationInKb != available {
+		t.Errorf("got %d KB available, want %d", stats.CapacityAvailableForVolumeAllocationInKb, available)
+	}
+
+	// thin provisioned volumes do not reserve their capacity
+	if _, err := c.CreateVolume(&scaleiotypes.VolumeParam{Name: "tf_thin", VolumeSizeInKb: "8388608"}, "pool1", ProtectionDomainID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateVolume(&scaleiotypes.VolumeParam{Name: "tf_thick", VolumeSizeInKb: "16777216", VolumeType: "ThickProvisioned"}, "pool1", ProtectionDomainID); err != nil {
+		t.Fatal(err)
+	}
+	stats, err = goscaleio.NewStoragePoolEx(c, pool).GetStatistics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.CapacityAvailableForVolumeAllocationInKb != available-16*kbPerGB {
+		t.Errorf("got %d KB available, want %d", stats.CapacityAvailableForVolumeAllocationInKb, available-16*kbPerGB)
+	}
+}
+
+func TestOverwriteVolumeContent(t *testing.T) {
+	_, c, system := connect(t)
+	ctx := context.Background()
+
+	snapshots, err := system.CreateSnapshotConsistencyGroup(&scaleiotypes.SnapshotVolumesParam{
+		SnapshotDefs: []*scaleiotypes.SnapshotDef{{VolumeID: VolumeID, SnapshotName: "tf_golden"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshotID := snapshots.VolumeIDList[0]
+	other, err := c.GetVolume("", "", "", "tf-volume-2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.OverwriteVolumeContent(ctx, c, VolumeID, &client.OverwriteVolumeContentParam{SrcVolumeID: snapshotID}); err != nil {
+		t.Fatal(err)
+	}
+	expectError(t, client.OverwriteVolumeContent(ctx, c, VolumeID, &client.OverwriteVolumeContentParam{SrcVolumeID: VolumeID}), "cannot overwrite a volume with itself")
+	expectError(t, client.OverwriteVolumeContent(ctx, c, other[0].ID, &client.OverwriteVolumeContentParam{SrcVolumeID: snapshotID}), "must be from the same V-Tree")
+	expectError(t, client.OverwriteVolumeContent(ctx, c, VolumeID, &client.OverwriteVolumeContentParam{SrcVolumeID: "missing"}), "Could not find the volume")
+}
+
+func TestPackages(t *testing.T) {
+	sim := New()
+	endpoint := sim.Start()
+	defer sim.Close()
+
+	dir := t.TempDir()
+	lia := filepath.Join(dir, "EMC-ScaleIO-lia-3.6-700.103.Ubuntu.22.04.x86_64.tar")
+	invalid := filepath.Join(dir, "abc.rpm")
+	for _, file := range []string{lia, invalid} {
+		if err := os.WriteFile(file, []byte("package"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gc, err := goscaleio.NewGateway(endpoint, sim.Username, sim.Password, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = gc.UploadPackages([]string{invalid})
+	expectError(t, err, "not a PowerFlex installation package")
+	if _, err := gc.UploadPackages([]string{lia}); err != nil {
+		t.Fatal(err)
+	}
+	packages, err := gc.GetPackageDetails()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(packages) != 1 || packages[0].Type != "lia" || packages[0].Version != "3.6" || packages[0].Size != 7 {
+		t.Errorf("unexpected packages %+v", packages)
+	}
+	if _, err := gc.DeletePackage(filepath.Base(lia)); err != nil {
+		t.Fatal(err)
+	}
+	if packages, _ := gc.GetPackageDetails(); len(packages) != 0 {
+		t.Errorf("the package should be deleted, got %+v", packages)
+	}
+
+	unauthorized, err := goscaleio.NewGateway(endpoint, sim.Username, "wrong", true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = unauthorized.UploadPackages([]string{lia})
+	expectError(t, err, "Unauthorized")
+}